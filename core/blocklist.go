@@ -0,0 +1,93 @@
+package core
+
+import (
+	"errors"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"log"
+	"time"
+)
+
+// IsBlocked returns true if address is on the blocklist. Blocked addresses
+// are refused during authentication of inbound connections, and can't be
+// added as contacts.
+func (cl *ContactList) IsBlocked(address string) bool {
+	_, blocked := cl.core.Config.Read().Blocked[address]
+	return blocked
+}
+
+// BlockedContacts returns the blocklist.
+func (cl *ContactList) BlockedContacts() []*ricochet.BlockedContact {
+	config := cl.core.Config.Read()
+	re := make([]*ricochet.BlockedContact, 0, len(config.Blocked))
+	for _, blocked := range config.Blocked {
+		re = append(re, blocked)
+	}
+	return re
+}
+
+// Block adds address to the blocklist. Any contact or inbound contact request
+// for the address is removed, and its connections are closed. To the peer,
+// this looks the same as being removed or having a request rejected.
+func (cl *ContactList) Block(address string) (*ricochet.BlockedContact, error) {
+	if !IsAddressValid(address) {
+		return nil, errors.New("Invalid ricochet address")
+	}
+
+	// A removed contact is destroyed after the mutex is unlocked, which is
+	// deferred later and so runs first
+	var removed *Contact
+	defer func() {
+		if removed != nil {
+			removed.Destroy()
+		}
+	}()
+
+	cl.mutex.Lock()
+	defer cl.mutex.Unlock()
+
+	if blocked := cl.core.Config.Read().Blocked[address]; blocked != nil {
+		return blocked, nil
+	}
+
+	blocked := &ricochet.BlockedContact{
+		Address:     address,
+		WhenBlocked: time.Now().Format(time.RFC3339),
+	}
+	config := cl.core.Config.Lock()
+	if config.Blocked == nil {
+		config.Blocked = make(map[string]*ricochet.BlockedContact)
+	}
+	config.Blocked[address] = blocked
+	if err := cl.core.Config.Unlock(); err != nil {
+		return nil, err
+	}
+	log.Printf("Blocked %s", address)
+
+	if contact := cl.contacts[address]; contact != nil {
+		cl.removeContact(contact)
+		removed = contact
+	}
+	if request := cl.inboundRequests[address]; request != nil {
+		cl.removeInboundRequest(request)
+	}
+
+	return blocked, nil
+}
+
+// Unblock removes address from the blocklist.
+func (cl *ContactList) Unblock(address string) error {
+	cl.mutex.Lock()
+	defer cl.mutex.Unlock()
+
+	if !cl.IsBlocked(address) {
+		return errors.New("Address is not blocked")
+	}
+
+	config := cl.core.Config.Lock()
+	delete(config.Blocked, address)
+	if err := cl.core.Config.Unlock(); err != nil {
+		return err
+	}
+	log.Printf("Unblocked %s", address)
+	return nil
+}
@@ -0,0 +1,197 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"os"
+	"reflect"
+	"time"
+)
+
+var (
+	boltRootBucket = []byte("config")
+	boltRootKey    = []byte("root")
+)
+
+// Map fields of Config which BoltStorage keeps as a bucket with one record
+// for each key, so that changing an entry doesn't rewrite any others. The
+// remaining fields of Config are stored together as a single record.
+//
+// Each of these must be a map from string to a message type.
+var boltRecordFields = []string{
+	"Contacts",
+	"InboundRequests",
+	"Blocked",
+	"Invites",
+	"ContactHistory",
+	"Introductions",
+}
+
+// BoltStorage keeps the configuration in an embedded transactional key-value
+// database. Each save is a single transaction that only writes the records
+// which changed, so frequent updates to individual contacts stay cheap with
+// a large contact list.
+type BoltStorage struct {
+	db *bolt.DB
+}
+
+// OpenBoltStorage opens or creates the database file at path. The file is
+// locked for the lifetime of the storage.
+func OpenBoltStorage(path string) (*BoltStorage, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(boltRootBucket); err != nil {
+			return err
+		}
+		for _, field := range boltRecordFields {
+			if _, err := tx.CreateBucketIfNotExists([]byte(field)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &BoltStorage{db: db}, nil
+}
+
+func (bs *BoltStorage) Load() (*ricochet.Config, error) {
+	config := &ricochet.Config{}
+
+	err := bs.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(boltRootBucket).Get(boltRootKey)
+		if data == nil {
+			return &os.PathError{Op: "load", Path: bs.db.Path(), Err: os.ErrNotExist}
+		}
+		if err := proto.Unmarshal(data, config); err != nil {
+			return err
+		}
+
+		for _, field := range boltRecordFields {
+			records := reflect.ValueOf(config).Elem().FieldByName(field)
+			records.Set(reflect.MakeMap(records.Type()))
+			valueType := records.Type().Elem().Elem()
+
+			err := tx.Bucket([]byte(field)).ForEach(func(k, v []byte) error {
+				value := reflect.New(valueType)
+				if err := proto.Unmarshal(v, value.Interface().(proto.Message)); err != nil {
+					return fmt.Errorf("Invalid record %s in %s: %v", k, field, err)
+				}
+				records.SetMapIndex(reflect.ValueOf(string(k)), value)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func (bs *BoltStorage) Save(prev, config *ricochet.Config) error {
+	return bs.db.Update(func(tx *bolt.Tx) error {
+		if prev == nil {
+			// Replace everything that was stored before
+			prev = &ricochet.Config{}
+			for _, field := range boltRecordFields {
+				if err := tx.DeleteBucket([]byte(field)); err != nil {
+					return err
+				}
+				if _, err := tx.CreateBucket([]byte(field)); err != nil {
+					return err
+				}
+			}
+			if err := tx.Bucket(boltRootBucket).Delete(boltRootKey); err != nil {
+				return err
+			}
+		}
+
+		// Compare and write everything other than records as one value
+		prevRoot, root := boltRootRecord(prev), boltRootRecord(config)
+		if !proto.Equal(prevRoot, root) || tx.Bucket(boltRootBucket).Get(boltRootKey) == nil {
+			data, err := proto.Marshal(root)
+			if err != nil {
+				return err
+			}
+			if err := tx.Bucket(boltRootBucket).Put(boltRootKey, data); err != nil {
+				return err
+			}
+		}
+
+		for _, field := range boltRecordFields {
+			if err := saveBoltRecords(tx.Bucket([]byte(field)), field, prev, config); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (bs *BoltStorage) Close() error {
+	return bs.db.Close()
+}
+
+// boltRootRecord returns a shallow copy of config without the fields that
+// are stored as separate records.
+func boltRootRecord(config *ricochet.Config) *ricochet.Config {
+	root := *config
+	value := reflect.ValueOf(&root).Elem()
+	for _, field := range boltRecordFields {
+		records := value.FieldByName(field)
+		records.Set(reflect.Zero(records.Type()))
+	}
+	return &root
+}
+
+// saveBoltRecords writes the entries of a map field that differ between prev
+// and config into bucket, and deletes entries that were removed.
+func saveBoltRecords(bucket *bolt.Bucket, field string, prev, config *ricochet.Config) error {
+	prevRecords := reflect.ValueOf(prev).Elem().FieldByName(field)
+	records := reflect.ValueOf(config).Elem().FieldByName(field)
+
+	for _, key := range records.MapKeys() {
+		value := records.MapIndex(key).Interface().(proto.Message)
+		if prevValue := prevRecords.MapIndex(key); prevValue.IsValid() &&
+			proto.Equal(prevValue.Interface().(proto.Message), value) {
+			continue
+		}
+
+		if reflect.ValueOf(value).IsNil() {
+			return errors.New("Config record cannot be nil")
+		}
+		data, err := proto.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if err := bucket.Put([]byte(key.String()), data); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range prevRecords.MapKeys() {
+		if records.MapIndex(key).IsValid() {
+			continue
+		}
+		if err := bucket.Delete([]byte(key.String())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
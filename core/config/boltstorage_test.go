@@ -0,0 +1,226 @@
+package config
+
+import (
+	"errors"
+	"github.com/boltdb/bolt"
+	"github.com/golang/protobuf/proto"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// failingStorage wraps a Storage, and fails every Save while fail is set
+// without passing it on.
+type failingStorage struct {
+	Storage
+	fail bool
+}
+
+func (fs *failingStorage) Save(prev, config *ricochet.Config) error {
+	if fs.fail {
+		return errors.New("Save failed for testing")
+	}
+	return fs.Storage.Save(prev, config)
+}
+
+// openTestBoltStorage opens a new database in a temporary directory, which
+// is removed by the returned function.
+func openTestBoltStorage(t *testing.T) (*BoltStorage, string, func()) {
+	dir, err := ioutil.TempDir("", "ricochet-config")
+	if err != nil {
+		t.Fatalf("Creating temporary directory failed: %v", err)
+	}
+	path := filepath.Join(dir, "ricochet.db")
+	storage, err := OpenBoltStorage(path)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("Opening storage failed: %v", err)
+	}
+	return storage, path, func() {
+		storage.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+// putBoltRecord replaces a record in the database directly.
+func putBoltRecord(t *testing.T, bs *BoltStorage, field, key string, value []byte) {
+	err := bs.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(field)).Put([]byte(key), value)
+	})
+	if err != nil {
+		t.Fatalf("Writing record %s in %s failed: %v", key, field, err)
+	}
+}
+
+func getBoltRecord(t *testing.T, bs *BoltStorage, field, key string) []byte {
+	var value []byte
+	err := bs.db.View(func(tx *bolt.Tx) error {
+		if data := tx.Bucket([]byte(field)).Get([]byte(key)); data != nil {
+			value = append([]byte{}, data...)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Reading record %s in %s failed: %v", key, field, err)
+	}
+	return value
+}
+
+func TestBoltStorageEmpty(t *testing.T) {
+	storage, _, cleanup := openTestBoltStorage(t)
+	defer cleanup()
+
+	if _, err := LoadConfig(storage); !os.IsNotExist(err) {
+		t.Errorf("Loading empty storage returned %v, expected a not exist error", err)
+	}
+}
+
+func TestBoltStorageRecords(t *testing.T) {
+	storage, _, cleanup := openTestBoltStorage(t)
+	defer cleanup()
+
+	cfg, err := NewConfig(storage)
+	if err != nil {
+		t.Fatalf("Creating config failed: %v", err)
+	}
+	config := cfg.Lock()
+	config.Contacts = map[string]*ricochet.Contact{
+		"alice": &ricochet.Contact{Nickname: "Alice"},
+		"bob":   &ricochet.Contact{Nickname: "Bob"},
+		"carol": &ricochet.Contact{Nickname: "Carol"},
+	}
+	if err := cfg.Unlock(); err != nil {
+		t.Fatalf("Saving config failed: %v", err)
+	}
+
+	// Replace the stored record for bob behind the config's back. It's only
+	// overwritten if bob's record is saved again.
+	marker := []byte("not rewritten")
+	putBoltRecord(t, storage, "Contacts", "bob", marker)
+
+	config = cfg.Lock()
+	config.Contacts["alice"].Nickname = "Alicia"
+	delete(config.Contacts, "carol")
+	if err := cfg.Unlock(); err != nil {
+		t.Fatalf("Saving config failed: %v", err)
+	}
+
+	if data := getBoltRecord(t, storage, "Contacts", "bob"); string(data) != string(marker) {
+		t.Error("Unchanged record was written again")
+	}
+	if data := getBoltRecord(t, storage, "Contacts", "carol"); data != nil {
+		t.Error("Removed record is still stored")
+	}
+
+	// Restore bob to load the rest
+	config = cfg.Lock()
+	config.Contacts["bob"].Nickname = "Robert"
+	if err := cfg.Unlock(); err != nil {
+		t.Fatalf("Saving config failed: %v", err)
+	}
+	loaded, err := storage.Load()
+	if err != nil {
+		t.Fatalf("Loading config failed: %v", err)
+	}
+	if len(loaded.Contacts) != 2 || loaded.Contacts["alice"].GetNickname() != "Alicia" ||
+		loaded.Contacts["bob"].GetNickname() != "Robert" {
+		t.Errorf("Loaded contacts don't match the saved ones: %v", loaded.Contacts)
+	}
+}
+
+// A change that failed to save is written by the next successful save, even
+// if that save changes something else.
+func TestBoltStorageSaveAfterFailure(t *testing.T) {
+	storage, _, cleanup := openTestBoltStorage(t)
+	defer cleanup()
+	failing := &failingStorage{Storage: storage}
+
+	cfg, err := NewConfig(failing)
+	if err != nil {
+		t.Fatalf("Creating config failed: %v", err)
+	}
+
+	failing.fail = true
+	config := cfg.Lock()
+	config.Contacts = map[string]*ricochet.Contact{
+		"alice": &ricochet.Contact{Nickname: "Alice"},
+	}
+	if err := cfg.Unlock(); err == nil {
+		t.Fatal("Save didn't fail")
+	}
+	if cfg.Read().Contacts["alice"] == nil {
+		t.Error("Change that failed to save isn't visible")
+	}
+
+	failing.fail = false
+	config = cfg.Lock()
+	config.Contacts["bob"] = &ricochet.Contact{Nickname: "Bob"}
+	if err := cfg.Unlock(); err != nil {
+		t.Fatalf("Saving config failed: %v", err)
+	}
+
+	loaded, err := storage.Load()
+	if err != nil {
+		t.Fatalf("Loading config failed: %v", err)
+	}
+	if loaded.Contacts["alice"] == nil {
+		t.Error("Change that failed to save wasn't saved later")
+	}
+	if loaded.Contacts["bob"] == nil {
+		t.Error("Change after a failed save wasn't saved")
+	}
+}
+
+func TestBoltStorageReopen(t *testing.T) {
+	storage, path, cleanup := openTestBoltStorage(t)
+	defer cleanup()
+
+	cfg, err := NewConfig(storage)
+	if err != nil {
+		t.Fatalf("Creating config failed: %v", err)
+	}
+	config := cfg.Lock()
+	config.Identity = &ricochet.Identity{Address: "ricochet:bbbbbbbbbbbbbbbb"}
+	config.Settings = &ricochet.Settings{AutoConnect: true}
+	config.Contacts = map[string]*ricochet.Contact{
+		"alice": &ricochet.Contact{Nickname: "Alice"},
+	}
+	config.Invites = map[string]*ricochet.Invite{
+		"token": &ricochet.Invite{Nickname: "Bob"},
+	}
+	if err := cfg.Unlock(); err != nil {
+		t.Fatalf("Saving config failed: %v", err)
+	}
+	saved := cfg.Read()
+	if err := cfg.Close(); err != nil {
+		t.Fatalf("Closing config failed: %v", err)
+	}
+
+	storage, err = OpenBoltStorage(path)
+	if err != nil {
+		t.Fatalf("Reopening storage failed: %v", err)
+	}
+	cfg, err = LoadConfig(storage)
+	if err != nil {
+		storage.Close()
+		t.Fatalf("Loading config failed: %v", err)
+	}
+	defer cfg.Close()
+	if !proto.Equal(cfg.Read(), saved) {
+		t.Errorf("Reopened config %v doesn't match saved config %v", cfg.Read(), saved)
+	}
+
+	// Records removed after reopening are deleted as well
+	config = cfg.Lock()
+	delete(config.Contacts, "alice")
+	if err := cfg.Unlock(); err != nil {
+		t.Fatalf("Saving config failed: %v", err)
+	}
+	if loaded, err := storage.Load(); err != nil {
+		t.Errorf("Loading config failed: %v", err)
+	} else if len(loaded.Contacts) != 0 {
+		t.Errorf("Removed contact is still stored: %v", loaded.Contacts)
+	}
+}
@@ -1,57 +1,99 @@
 package config
 
 import (
-	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
+	"github.com/ricochet-im/ricochet-go/core/utils"
 	"github.com/ricochet-im/ricochet-go/rpc"
 	"log"
-	"os"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
+// ConfigFile holds the configuration in memory, provides consistent snapshots
+// of it to readers, and saves every change to its Storage.
+//
+// After every change, the new snapshot is published to EventMonitor() as a
+// *ricochet.Config, which is read-only in the same way as Read().
 type ConfigFile struct {
-	filePath     string
+	storage      Storage
 	root         *ricochet.Config
 	readSnapshot atomic.Value
 	mutex        sync.Mutex
+	events       *utils.Publisher
+
+	// The configuration as it was last saved successfully, which changes
+	// are compared against when saving; nil to replace everything stored
+	lastSaved *ricochet.Config
+
+	statusMutex sync.Mutex
+	status      ricochet.ConfigStatus
 }
 
+// NewConfigFile creates a new, empty configuration file at path.
 func NewConfigFile(path string) (*ConfigFile, error) {
+	return NewConfig(NewFileStorage(path))
+}
+
+// LoadConfigFile reads an existing configuration file from path. See
+// FileStorage for details on recovery of damaged files. If the file doesn't
+// exist, the error satisfies os.IsNotExist.
+func LoadConfigFile(path string) (*ConfigFile, error) {
+	return LoadConfig(NewFileStorage(path))
+}
+
+// NewConfig creates a new, empty configuration and saves it to storage,
+// replacing anything that was stored before.
+func NewConfig(storage Storage) (*ConfigFile, error) {
 	cfg := &ConfigFile{
-		filePath: path,
-		root:     &ricochet.Config{},
+		storage: storage,
+		root:    &ricochet.Config{},
 	}
 	cfg.readSnapshot.Store(cfg.root)
 	if err := cfg.save(); err != nil {
 		return nil, err
 	}
+	cfg.events = utils.CreatePublisher()
 	return cfg, nil
 }
 
-func LoadConfigFile(path string) (*ConfigFile, error) {
+// LoadConfig reads the configuration from storage. If the stored data is
+// damaged and the storage supports recovery, the newest valid copy is used
+// and noted in Status(). If storage is empty, the error satisfies os.IsNotExist.
+func LoadConfig(storage Storage) (*ConfigFile, error) {
 	cfg := &ConfigFile{
-		filePath: path,
-		root:     &ricochet.Config{},
+		storage: storage,
 	}
 
-	file, err := os.Open(cfg.filePath)
+	root, err := storage.Load()
 	if err != nil {
-		return nil, err
-	}
-	defer file.Close()
+		log.Printf("Config load error: %v", err)
+		recovery, ok := storage.(recoverableStorage)
+		if !ok {
+			return nil, err
+		}
 
-	json := jsonpb.Unmarshaler{
-		AllowUnknownFields: true,
-	}
-	if err := json.Unmarshal(file, cfg.root); err != nil {
-		return nil, err
+		var recoverErr error
+		root, cfg.status.RecoveredFrom, recoverErr = recovery.Recover()
+		if recoverErr != nil {
+			// Report the original error; IsNotExist is significant to callers
+			return nil, err
+		}
 	}
 
+	cfg.root = root
+	cfg.lastSaved = root
 	cfg.readSnapshot.Store(cfg.root)
+	cfg.events = utils.CreatePublisher()
 	return cfg, nil
 }
 
+// EventMonitor publishes a read-only *ricochet.Config snapshot after each
+// change to the configuration.
+func (cfg *ConfigFile) EventMonitor() utils.Subscribable {
+	return cfg.events
+}
+
 // Read returns a **read-only** snapshot of the current configuration. This
 // function is threadsafe, and the values in this instance of the configuration
 // will not change when the configuration changes.
@@ -72,7 +114,19 @@ func (cfg *ConfigFile) Lock() *ricochet.Config {
 	return cfg.root
 }
 
-func (cfg *ConfigFile) Unlock() {
+// Unlock publishes changes made since Lock() and saves them. If the
+// configuration cannot be saved, the changes remain visible through Read(),
+// the error is recorded in Status(), and it's returned to the caller. Those
+// changes are saved again along with the next change.
+func (cfg *ConfigFile) Unlock() error {
+	prev := cfg.Read()
+	if proto.Equal(prev, cfg.root) {
+		// Nothing changed; keep the previous snapshot and skip saving
+		cfg.root = prev
+		cfg.mutex.Unlock()
+		return nil
+	}
+
 	// Clone cfg.root again to guarantee that any messages are detached from
 	// instances that exist elsewhere in the code. Inefficient but safe.
 	cfg.root = proto.Clone(cfg.root).(*ricochet.Config)
@@ -81,35 +135,87 @@ func (cfg *ConfigFile) Unlock() {
 	if err != nil {
 		log.Printf("WARNING: Unable to save configuration: %s", err)
 	}
+	// Publish while locked to guarantee that events are in order
+	cfg.events.Publish(cfg.root)
 	cfg.mutex.Unlock()
+	return err
 }
 
-func (cfg *ConfigFile) save() error {
-	json := jsonpb.Marshaler{Indent: "  "}
-
-	// Make a pathetic attempt at atomic file write by writing into a
-	// temporary file and renaming over the original; this is probably
-	// imperfect as-implemented, but better than truncating and writing
-	// directly.
-	tempPath := cfg.filePath + ".new"
-	file, err := os.OpenFile(tempPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+// Reload reads the configuration from storage again to pick up changes that
+// were made outside of this process, such as by editing the file by hand.
+//
+// merge is called with the current configuration and the reloaded one while
+// the configuration is locked. It must validate the reloaded configuration,
+// and may modify it to keep any state that shouldn't be replaced. If merge
+// returns an error, the reload is rejected and the current configuration
+// (which will be saved again at the next change) is unchanged. Otherwise,
+// the merged configuration replaces the current one, is saved, and is
+// returned as a read-only snapshot.
+func (cfg *ConfigFile) Reload(merge func(current, loaded *ricochet.Config) error) (*ricochet.Config, error) {
+	cfg.mutex.Lock()
+	defer cfg.mutex.Unlock()
+
+	loaded, err := cfg.storage.Load()
 	if err != nil {
-		log.Printf("Config save error: %v", err)
-		return err
+		return nil, err
+	}
+	stored := proto.Clone(loaded).(*ricochet.Config)
+	if err := merge(cfg.root, loaded); err != nil {
+		return nil, err
 	}
 
-	err = json.Marshal(file, cfg.root)
-	if err != nil {
-		log.Printf("Config encoding error: %v", err)
-		file.Close()
-		return err
+	// Storage now has the reloaded configuration, even if earlier changes
+	// weren't saved
+	cfg.lastSaved = stored
+	cfg.root = proto.Clone(loaded).(*ricochet.Config)
+	cfg.readSnapshot.Store(cfg.root)
+	if err := cfg.save(); err != nil {
+		log.Printf("WARNING: Unable to save configuration: %s", err)
 	}
+	cfg.events.Publish(cfg.root)
+	return cfg.root, nil
+}
 
-	file.Close()
-	if err := os.Rename(tempPath, cfg.filePath); err != nil {
-		log.Printf("Config replace error: %v", err)
-		return err
+// Close releases the storage. The ConfigFile must not be used afterwards.
+func (cfg *ConfigFile) Close() error {
+	cfg.mutex.Lock()
+	defer cfg.mutex.Unlock()
+	cfg.events.Close()
+	return cfg.storage.Close()
+}
+
+// IsEphemeral returns true if the configuration is only kept in memory, and
+// will be lost when the process exits.
+func (cfg *ConfigFile) IsEphemeral() bool {
+	_, ok := cfg.storage.(*MemoryStorage)
+	return ok
+}
+
+// Status returns the health of the persistent configuration, including any
+// error from the most recent save.
+func (cfg *ConfigFile) Status() ricochet.ConfigStatus {
+	cfg.statusMutex.Lock()
+	defer cfg.statusMutex.Unlock()
+	return cfg.status
+}
+
+// save writes the changes in cfg.root since the last successful save to
+// storage, and records the result in the status. If saving fails, storage is
+// unchanged, and those changes are written again by the next save. Assumes
+// the mutex is held.
+func (cfg *ConfigFile) save() error {
+	err := cfg.storage.Save(cfg.lastSaved, cfg.root)
+	if err == nil {
+		cfg.lastSaved = cfg.root
 	}
 
-	return nil
+	cfg.statusMutex.Lock()
+	defer cfg.statusMutex.Unlock()
+	if err != nil {
+		cfg.status.SaveError = err.Error()
+	} else {
+		cfg.status.SaveError = ""
+		cfg.status.LastSaved = time.Now().Format(time.RFC3339)
+	}
+	return err
 }
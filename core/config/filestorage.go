@@ -0,0 +1,175 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// Number of previous versions of the configuration file that are kept as
+// backups, named with the suffixes ".1" (newest) through ".N" (oldest).
+const BackupCount = 3
+
+// FileStorage keeps the configuration as a single JSON file, which is
+// rewritten entirely for every change.
+type FileStorage struct {
+	filePath string
+}
+
+func NewFileStorage(path string) *FileStorage {
+	return &FileStorage{filePath: path}
+}
+
+// Load reads the configuration file. It fails if the file is missing,
+// truncated, or otherwise can't be parsed.
+func (fs *FileStorage) Load() (*ricochet.Config, error) {
+	return readConfig(fs.filePath)
+}
+
+// Recover loads the newest valid backup when the configuration file is
+// missing or damaged. The damaged file is moved aside with a ".corrupt"
+// suffix and the recovered configuration is saved in its place.
+func (fs *FileStorage) Recover() (*ricochet.Config, string, error) {
+	root, recoveredPath, err := fs.readNewestBackup()
+	if err != nil {
+		return nil, "", err
+	}
+	log.Printf("WARNING: Recovered configuration from backup %s", recoveredPath)
+
+	if err := os.Rename(fs.filePath, fs.filePath+".corrupt"); err != nil && !os.IsNotExist(err) {
+		return nil, "", err
+	}
+
+	if err := fs.Save(nil, root); err != nil {
+		return nil, "", err
+	}
+	return root, recoveredPath, nil
+}
+
+func readConfig(path string) (*ricochet.Config, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal will reject empty and truncated files as well as invalid syntax
+	json := jsonpb.Unmarshaler{
+		AllowUnknownFields: true,
+	}
+	root := &ricochet.Config{}
+	if err := json.Unmarshal(bytes.NewReader(data), root); err != nil {
+		return nil, fmt.Errorf("Config file %s is damaged: %v", path, err)
+	}
+	return root, nil
+}
+
+// readNewestBackup attempts to load the newest valid copy of the configuration
+// from an interrupted save or from the rotating backups, in that order.
+func (fs *FileStorage) readNewestBackup() (*ricochet.Config, string, error) {
+	paths := []string{fs.filePath + ".new"}
+	for i := 1; i <= BackupCount; i++ {
+		paths = append(paths, fs.backupPath(i))
+	}
+
+	for _, path := range paths {
+		root, err := readConfig(path)
+		if err == nil {
+			return root, path, nil
+		} else if !os.IsNotExist(err) {
+			log.Printf("Ignoring config backup: %v", err)
+		}
+	}
+
+	return nil, "", fmt.Errorf("No valid config backup for %s", fs.filePath)
+}
+
+func (fs *FileStorage) backupPath(n int) string {
+	return fmt.Sprintf("%s.%d", fs.filePath, n)
+}
+
+// Save durably replaces the configuration file. The new configuration is
+// written and synced to a temporary file, previous versions are rotated into
+// backups, and the temporary file is renamed into place before syncing the
+// directory. An interruption at any point leaves either the old or the new
+// file, or a valid copy that Load will recover.
+func (fs *FileStorage) Save(prev, config *ricochet.Config) error {
+	json := jsonpb.Marshaler{Indent: "  "}
+
+	tempPath := fs.filePath + ".new"
+	file, err := os.OpenFile(tempPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		log.Printf("Config save error: %v", err)
+		return err
+	}
+
+	err = json.Marshal(file, config)
+	if err != nil {
+		log.Printf("Config encoding error: %v", err)
+		file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		log.Printf("Config sync error: %v", err)
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		log.Printf("Config save error: %v", err)
+		return err
+	}
+
+	if err := fs.rotateBackups(); err != nil {
+		log.Printf("Config backup error: %v", err)
+		return err
+	}
+
+	if err := os.Rename(tempPath, fs.filePath); err != nil {
+		log.Printf("Config replace error: %v", err)
+		return err
+	}
+
+	if err := syncDir(filepath.Dir(fs.filePath)); err != nil {
+		log.Printf("Config sync error: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+func (fs *FileStorage) Close() error {
+	return nil
+}
+
+// rotateBackups shifts each backup to the next older slot, discarding the
+// oldest, and moves the current file into the newest slot.
+func (fs *FileStorage) rotateBackups() error {
+	for i := BackupCount - 1; i > 0; i-- {
+		err := os.Rename(fs.backupPath(i), fs.backupPath(i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	err := os.Rename(fs.filePath, fs.backupPath(1))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// syncDir flushes directory entries, making renames in that directory durable.
+func syncDir(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+	return dir.Sync()
+}
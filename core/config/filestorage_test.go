@@ -0,0 +1,165 @@
+package config
+
+import (
+	"fmt"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// newTestConfigFile creates a config file in a temporary directory, which is
+// removed by the returned function, and saves it once for each nickname.
+func newTestConfigFile(t *testing.T, nicknames ...string) (*ConfigFile, string, func()) {
+	dir, err := ioutil.TempDir("", "ricochet-config")
+	if err != nil {
+		t.Fatalf("Creating temporary directory failed: %v", err)
+	}
+	path := filepath.Join(dir, "ricochet.json")
+	cfg, err := NewConfigFile(path)
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatalf("Creating config failed: %v", err)
+	}
+
+	for _, nickname := range nicknames {
+		setTestNickname(cfg, nickname)
+		if err := cfg.Unlock(); err != nil {
+			os.RemoveAll(dir)
+			t.Fatalf("Saving config failed: %v", err)
+		}
+	}
+	return cfg, path, func() {
+		os.RemoveAll(dir)
+	}
+}
+
+// setTestNickname locks cfg and changes the nickname of the only contact.
+func setTestNickname(cfg *ConfigFile, nickname string) {
+	config := cfg.Lock()
+	config.Contacts = map[string]*ricochet.Contact{
+		"alice": &ricochet.Contact{Nickname: nickname},
+	}
+}
+
+// readTestNickname returns the nickname of the only contact in the config file
+// at path.
+func readTestNickname(path string) (string, error) {
+	config, err := readConfig(path)
+	if err != nil {
+		return "", err
+	}
+	return config.Contacts["alice"].GetNickname(), nil
+}
+
+func TestFileStorageBackups(t *testing.T) {
+	nicknames := []string{"one", "two", "three", "four", "five"}
+	_, path, cleanup := newTestConfigFile(t, nicknames...)
+	defer cleanup()
+
+	if nickname, err := readTestNickname(path); err != nil || nickname != "five" {
+		t.Errorf("Config file has %q (%v), expected the last save", nickname, err)
+	}
+	for i := 1; i <= BackupCount; i++ {
+		backup := fmt.Sprintf("%s.%d", path, i)
+		expected := nicknames[len(nicknames)-1-i]
+		if nickname, err := readTestNickname(backup); err != nil || nickname != expected {
+			t.Errorf("Backup %d has %q (%v), expected %q", i, nickname, err, expected)
+		}
+	}
+	if _, err := os.Stat(fmt.Sprintf("%s.%d", path, BackupCount+1)); !os.IsNotExist(err) {
+		t.Errorf("More than %d backups are kept", BackupCount)
+	}
+	if _, err := os.Stat(path + ".new"); !os.IsNotExist(err) {
+		t.Error("Temporary file is left after saving")
+	}
+}
+
+func TestFileStorageRecovery(t *testing.T) {
+	_, path, cleanup := newTestConfigFile(t, "one", "two", "three")
+	defer cleanup()
+
+	// Truncate the config file, and damage the newest backup as well
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Reading config failed: %v", err)
+	}
+	if err := ioutil.WriteFile(path, data[:len(data)/2], 0600); err != nil {
+		t.Fatalf("Truncating config failed: %v", err)
+	}
+	if err := ioutil.WriteFile(path+".1", []byte("{\"contacts\": 1}"), 0600); err != nil {
+		t.Fatalf("Damaging backup failed: %v", err)
+	}
+
+	cfg, err := LoadConfigFile(path)
+	if err != nil {
+		t.Fatalf("Loading damaged config failed: %v", err)
+	}
+	if nickname := cfg.Read().Contacts["alice"].GetNickname(); nickname != "one" {
+		t.Errorf("Recovered %q, expected the newest valid backup", nickname)
+	}
+	if status := cfg.Status(); status.RecoveredFrom != path+".2" {
+		t.Errorf("Recovered from %q, expected %q", status.RecoveredFrom, path+".2")
+	}
+
+	// The damaged file is kept aside and replaced by the recovered config
+	if corrupt, err := ioutil.ReadFile(path + ".corrupt"); err != nil || len(corrupt) != len(data)/2 {
+		t.Errorf("Damaged config was not kept (%v)", err)
+	}
+	if nickname, err := readTestNickname(path); err != nil || nickname != "one" {
+		t.Errorf("Config file has %q (%v) after recovery, expected the recovered config", nickname, err)
+	}
+}
+
+func TestFileStorageNoBackup(t *testing.T) {
+	_, path, cleanup := newTestConfigFile(t)
+	defer cleanup()
+
+	if err := ioutil.WriteFile(path, nil, 0600); err != nil {
+		t.Fatalf("Truncating config failed: %v", err)
+	}
+	if _, err := LoadConfigFile(path); err == nil {
+		t.Error("Loading damaged config without a backup succeeded")
+	}
+	if _, err := LoadConfigFile(path + ".missing"); !os.IsNotExist(err) {
+		t.Errorf("Loading missing config returned %v, expected a not exist error", err)
+	}
+}
+
+func TestFileStorageSaveError(t *testing.T) {
+	cfg, path, cleanup := newTestConfigFile(t, "one")
+	defer cleanup()
+
+	// Saving fails while the directory doesn't exist
+	dir := filepath.Dir(path)
+	if err := os.RemoveAll(dir); err != nil {
+		t.Fatalf("Removing directory failed: %v", err)
+	}
+	setTestNickname(cfg, "two")
+	if err := cfg.Unlock(); err == nil {
+		t.Fatal("Saving config without a directory succeeded")
+	}
+	if cfg.Status().SaveError == "" {
+		t.Error("Save error is not recorded in the status")
+	}
+	if nickname := cfg.Read().Contacts["alice"].GetNickname(); nickname != "two" {
+		t.Errorf("Config has %q after a failed save, expected the change", nickname)
+	}
+
+	// The change is saved with the next one
+	if err := os.Mkdir(dir, 0700); err != nil {
+		t.Fatalf("Creating directory failed: %v", err)
+	}
+	config := cfg.Lock()
+	config.Settings = &ricochet.Settings{AutoConnect: true}
+	if err := cfg.Unlock(); err != nil {
+		t.Fatalf("Saving config failed: %v", err)
+	}
+	if status := cfg.Status(); status.SaveError != "" || status.LastSaved == "" {
+		t.Errorf("Status after a successful save is %v", status)
+	}
+	if nickname, err := readTestNickname(path); err != nil || nickname != "two" {
+		t.Errorf("Config file has %q (%v), expected the change that failed to save", nickname, err)
+	}
+}
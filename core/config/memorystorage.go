@@ -0,0 +1,47 @@
+package config
+
+import (
+	"errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"os"
+	"sync"
+)
+
+// MemoryStorage keeps the configuration in memory only. Nothing is written
+// to disk, and everything is lost when the process exits.
+type MemoryStorage struct {
+	mutex  sync.Mutex
+	config *ricochet.Config
+	closed bool
+}
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{}
+}
+
+func (ms *MemoryStorage) Load() (*ricochet.Config, error) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	if ms.config == nil {
+		return nil, &os.PathError{Op: "load", Path: "memory", Err: os.ErrNotExist}
+	}
+	return proto.Clone(ms.config).(*ricochet.Config), nil
+}
+
+func (ms *MemoryStorage) Save(prev, config *ricochet.Config) error {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	if ms.closed {
+		return errors.New("Storage is closed")
+	}
+	ms.config = proto.Clone(config).(*ricochet.Config)
+	return nil
+}
+
+func (ms *MemoryStorage) Close() error {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+	ms.closed = true
+	return nil
+}
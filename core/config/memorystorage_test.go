@@ -0,0 +1,51 @@
+package config
+
+import (
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"os"
+	"testing"
+)
+
+func TestMemoryStorage(t *testing.T) {
+	storage := NewMemoryStorage()
+	if _, err := LoadConfig(storage); !os.IsNotExist(err) {
+		t.Errorf("Loading empty storage returned %v, expected a not exist error", err)
+	}
+
+	cfg, err := NewConfig(storage)
+	if err != nil {
+		t.Fatalf("Creating config failed: %v", err)
+	}
+	if !cfg.IsEphemeral() {
+		t.Error("Config in memory is not ephemeral")
+	}
+
+	config := cfg.Lock()
+	config.Contacts = map[string]*ricochet.Contact{
+		"alice": &ricochet.Contact{Nickname: "Alice"},
+	}
+	if err := cfg.Unlock(); err != nil {
+		t.Fatalf("Saving config failed: %v", err)
+	}
+
+	loaded, err := storage.Load()
+	if err != nil {
+		t.Fatalf("Loading config failed: %v", err)
+	}
+	if loaded.Contacts["alice"].GetNickname() != "Alice" {
+		t.Errorf("Loaded config doesn't match the saved one: %v", loaded)
+	}
+
+	// Loaded copies don't share anything with the stored config
+	loaded.Contacts["alice"].Nickname = "Alicia"
+	if loaded, _ := storage.Load(); loaded.Contacts["alice"].GetNickname() != "Alice" {
+		t.Error("Changing a loaded config changed the stored one")
+	}
+
+	if err := cfg.Close(); err != nil {
+		t.Fatalf("Closing config failed: %v", err)
+	}
+	if err := storage.Save(nil, &ricochet.Config{}); err == nil {
+		t.Error("Saving to closed storage succeeded")
+	}
+}
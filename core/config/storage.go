@@ -0,0 +1,29 @@
+package config
+
+import (
+	"github.com/ricochet-im/ricochet-go/rpc"
+)
+
+// Storage is a persistent backend for the configuration held by ConfigFile.
+// ConfigFile serializes all calls to Load and Save.
+type Storage interface {
+	// Load returns the complete stored configuration. If nothing has been
+	// stored yet, the error satisfies os.IsNotExist.
+	Load() (*ricochet.Config, error)
+	// Save persists config, which replaces prev, the configuration that was
+	// most recently loaded or successfully saved. prev is nil when everything
+	// stored before should be replaced.
+	// Implementations may compare the two to write only changed records.
+	// Neither instance will be modified, and both may be retained.
+	Save(prev, config *ricochet.Config) error
+	Close() error
+}
+
+// recoverableStorage is implemented by storage that can recover from damaged
+// data by falling back to an older copy.
+type recoverableStorage interface {
+	// Recover is called when Load fails while opening the configuration. It
+	// returns the newest valid copy of the configuration, which has been
+	// restored as the current data, and a description of where it came from.
+	Recover() (*ricochet.Config, string, error)
+}
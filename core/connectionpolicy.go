@@ -0,0 +1,59 @@
+package core
+
+import (
+	"time"
+)
+
+// When two peers connect to each other at the same time, each of them has an
+// inbound and an outbound connection, and both must choose the same one to
+// keep. The rules for that choice are kept here as functions of the state of
+// the connections, independent of the connection loop that applies them.
+
+// outboundState is the progress of an outbound connection attempt
+type outboundState int
+
+const (
+	// No outbound connection attempt is running
+	outboundIdle outboundState = iota
+	// Connecting or negotiating; the peer hasn't seen our authentication
+	outboundConnecting
+	// Authentication was sent, so the peer may already be using this connection
+	outboundAuthenticating
+)
+
+// An established connection older than this is always replaced by a new one,
+// because the peer has evidently decided to reconnect.
+const connectionReplaceAge = 30 * time.Second
+
+// preferOutbound returns true if our outbound connection to a peer wins over
+// their inbound connection when both are made at once. Peers always reach
+// opposite answers, so they agree on the same connection.
+func preferOutbound(myHostname, peerHostname string) bool {
+	return myHostname < peerHostname
+}
+
+// shouldKeepInbound returns true if a new inbound connection should be used
+// when there's no established connection, and an outbound attempt is in the
+// given state. An outbound attempt is cancelled when the inbound connection
+// is kept. It keeps going otherwise, because it has sent authentication and
+// will win the comparison, so the peer will use it.
+func shouldKeepInbound(outbound outboundState, preferOutbound bool) bool {
+	if outbound != outboundAuthenticating {
+		return true
+	}
+	return !preferOutbound
+}
+
+// shouldReplaceConnection returns true if a new connection should replace an
+// established connection of the given direction and age.
+func shouldReplaceConnection(existingInbound bool, existingAge time.Duration, newInbound, preferOutbound bool) bool {
+	if existingInbound == newInbound {
+		// Same direction, which means the peer reconnected
+		return true
+	} else if existingAge > connectionReplaceAge {
+		return true
+	}
+	// Connections were made at about the same time; the new connection wins
+	// if it is the direction that's preferred.
+	return preferOutbound != newInbound
+}
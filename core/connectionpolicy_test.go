@@ -0,0 +1,131 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+// Hostnames for the two peers in these tests, in both orders
+var connectionPolicyHostnames = [][2]string{
+	{"aaaaaaaaaaaaaaaa", "bbbbbbbbbbbbbbbb"},
+	{"bbbbbbbbbbbbbbbb", "aaaaaaaaaaaaaaaa"},
+}
+
+var outboundStateNames = map[outboundState]string{
+	outboundIdle:           "idle",
+	outboundConnecting:     "connecting",
+	outboundAuthenticating: "authenticating",
+}
+
+func TestPreferOutbound(t *testing.T) {
+	for _, hosts := range connectionPolicyHostnames {
+		if preferOutbound(hosts[0], hosts[1]) == preferOutbound(hosts[1], hosts[0]) {
+			t.Errorf("Peers %s and %s both prefer the same direction", hosts[0], hosts[1])
+		}
+	}
+}
+
+// Peers A and B connect to each other at once. The connection made by A is
+// called "a", and the one made by B is "b". B has sent authentication on b,
+// which arrives at A while A's outbound attempt is in each state; each peer
+// decides which connection to use, and they must agree.
+func TestSimultaneousConnections(t *testing.T) {
+	for _, hosts := range connectionPolicyHostnames {
+		for state, stateName := range outboundStateNames {
+			preferA := preferOutbound(hosts[0], hosts[1])
+			preferB := preferOutbound(hosts[1], hosts[0])
+
+			// A either uses b and cancels a, or keeps trying a and closes b
+			aUses := "a"
+			if shouldKeepInbound(state, preferA) {
+				aUses = "b"
+			}
+
+			var bUses string
+			if aUses == "a" {
+				// b was closed, so B is connecting again when a arrives
+				bUses = "b"
+				if shouldKeepInbound(outboundConnecting, preferB) {
+					bUses = "a"
+				}
+			} else {
+				bUses = "b"
+				if state == outboundAuthenticating {
+					// a had already sent authentication, so it arrives at B while
+					// b is either still authenticating or established
+					if shouldKeepInbound(outboundAuthenticating, preferB) {
+						bUses = "a"
+					}
+					if shouldReplaceConnection(false, 0, true, preferB) {
+						t.Errorf("%s to %s, A %s: B replaces b with a after A used b",
+							hosts[0], hosts[1], stateName)
+					}
+				}
+			}
+
+			if aUses != bUses {
+				t.Errorf("%s to %s, A %s: A uses %s and B uses %s",
+					hosts[0], hosts[1], stateName, aUses, bUses)
+			}
+		}
+	}
+}
+
+func otherConnection(name string) string {
+	if name == "a" {
+		return "b"
+	}
+	return "a"
+}
+
+// Both connections are established on both peers, in either order on each
+// peer, before either is closed. The peers must keep the same connection.
+func TestReplaceSimultaneousConnection(t *testing.T) {
+	// Which connection arrives first at a peer, named by its direction there
+	firstInbound := []bool{false, true}
+
+	for _, hosts := range connectionPolicyHostnames {
+		preferA := preferOutbound(hosts[0], hosts[1])
+		preferB := preferOutbound(hosts[1], hosts[0])
+		for _, aFirstInbound := range firstInbound {
+			for _, bFirstInbound := range firstInbound {
+				// On A, a is outbound and b is inbound; on B, the opposite
+				aUses := "a"
+				if aFirstInbound {
+					aUses = "b"
+				}
+				if shouldReplaceConnection(aFirstInbound, time.Second, !aFirstInbound, preferA) {
+					aUses = otherConnection(aUses)
+				}
+				bUses := "b"
+				if bFirstInbound {
+					bUses = "a"
+				}
+				if shouldReplaceConnection(bFirstInbound, time.Second, !bFirstInbound, preferB) {
+					bUses = otherConnection(bUses)
+				}
+
+				if aUses != bUses {
+					t.Errorf("%s to %s, inbound first on A %v and B %v: A uses %s and B uses %s",
+						hosts[0], hosts[1], aFirstInbound, bFirstInbound, aUses, bUses)
+				}
+			}
+		}
+	}
+}
+
+func TestReplaceConnection(t *testing.T) {
+	old := connectionReplaceAge + time.Second
+	for _, prefer := range []bool{false, true} {
+		for _, existingInbound := range []bool{false, true} {
+			// A new connection in the same direction means the peer reconnected
+			if !shouldReplaceConnection(existingInbound, 0, existingInbound, prefer) {
+				t.Errorf("New connection in the same direction (inbound %v) did not replace the existing one", existingInbound)
+			}
+			// Old connections are always replaced
+			if !shouldReplaceConnection(existingInbound, old, !existingInbound, prefer) {
+				t.Errorf("Connection older than %v (inbound %v) was not replaced", connectionReplaceAge, existingInbound)
+			}
+		}
+	}
+}
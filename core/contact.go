@@ -1,6 +1,7 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 	"github.com/golang/protobuf/proto"
 	"github.com/ricochet-im/ricochet-go/core/utils"
@@ -27,6 +28,14 @@ type Contact struct {
 	connChannel       chan *connection.Connection
 	connEnabledSignal chan bool
 	connectionOnce    sync.Once
+	outbound          outboundState // progress of the outbound attempt, for considerUsingConnection
+	// Closed by Destroy, and closed when contactConnection has exited
+	destroyed      chan struct{}
+	destroyOnce    sync.Once
+	connectionDone chan struct{}
+	outboundWait   sync.WaitGroup
+	// Set by markRemoved; the contact's data is no longer saved to the config
+	removed bool
 
 	timeConnected time.Time
 
@@ -40,6 +49,8 @@ func ContactFromConfig(core *Ricochet, data *ricochet.Contact, events *utils.Pub
 		events:            events,
 		connChannel:       make(chan *connection.Connection),
 		connEnabledSignal: make(chan bool),
+		destroyed:         make(chan struct{}),
+		connectionDone:    make(chan struct{}),
 	}
 
 	if !IsAddressValid(data.Address) {
@@ -131,22 +142,81 @@ func (c *Contact) Connection() *connection.Connection {
 // StartConnection enables inbound and outbound connections for this contact, if other
 // conditions permit them. This function is safe to call repeatedly.
 func (c *Contact) StartConnection() {
-	c.connectionOnce.Do(func() {
-		go c.contactConnection()
-	})
-
+	c.mutex.Lock()
 	c.connEnabled = true
-	c.connEnabledSignal <- true
+	c.mutex.Unlock()
+	c.refreshConnectionState()
 }
 
 func (c *Contact) StopConnection() {
+	c.mutex.Lock()
+	c.connEnabled = false
+	c.mutex.Unlock()
+	c.refreshConnectionState()
+}
+
+// refreshConnectionState signals the connection loop to enable or disable
+// connections after connEnabled or the hidden state have changed. It waits
+// until the connection loop receives the signal, which can take until the
+// loop is done with a connection, so it must not be called while holding the
+// mutex of the contact or the contact list. The only exception is a new
+// contact, whose loop is idle until the contact list is unlocked.
+func (c *Contact) refreshConnectionState() {
 	// Must be running to consume connEnabledSignal
+	c.startConnectionLoop()
+
+	c.mutex.Lock()
+	enabled := c.connEnabled
+	c.mutex.Unlock()
+
+	select {
+	case c.connEnabledSignal <- enabled:
+	case <-c.destroyed:
+	}
+}
+
+func (c *Contact) IsHidden() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.data.Hidden
+}
+
+func (c *Contact) startConnectionLoop() {
 	c.connectionOnce.Do(func() {
 		go c.contactConnection()
 	})
+}
 
-	c.connEnabled = false
-	c.connEnabledSignal <- false
+// Destroy permanently stops all connections for a contact that is being
+// removed. Outbound connection attempts are cancelled and the active
+// connection is closed, and Destroy returns when all of the goroutines for
+// connections have exited. The conversation is closed, and neither the
+// contact or conversation may be used afterwards.
+func (c *Contact) Destroy() {
+	c.destroyOnce.Do(func() {
+		close(c.destroyed)
+	})
+	// If the connection loop was never started, there is nothing to wait for
+	c.connectionOnce.Do(func() {
+		close(c.connectionDone)
+	})
+	<-c.connectionDone
+
+	c.mutex.Lock()
+	conversation := c.conversation
+	c.mutex.Unlock()
+	if conversation != nil {
+		conversation.close()
+	}
+}
+
+// markRemoved stops the contact from saving its data to the config, so that
+// it can be removed from the config before its connections are stopped by
+// Destroy.
+func (c *Contact) markRemoved() {
+	c.mutex.Lock()
+	c.removed = true
+	c.mutex.Unlock()
 }
 
 func (c *Contact) shouldMakeOutboundConnections() bool {
@@ -157,6 +227,10 @@ func (c *Contact) shouldMakeOutboundConnections() bool {
 	if c.data.Status == ricochet.Contact_REJECTED {
 		return false
 	}
+	// Never contact blocked addresses
+	if _, blocked := c.core.Config.Read().Blocked[c.data.Address]; blocked {
+		return false
+	}
 
 	return c.connEnabled
 }
@@ -178,14 +252,36 @@ func closeUnhandledConnection(conn *connection.Connection) {
 // reacting to connection loss. Nothing else may write Contact.connection.
 //
 // This goroutine is started by the first call to StartConnection or StopConnection
-// and persists for the lifetime of the contact. When connections are stopped, it
-// consumes connChannel and closes all (presumably inbound) connections.
-// XXX Need a hard kill for destroying contacts
+// and persists until the contact is destroyed. When connections are stopped or the
+// contact is hidden, it closes the active connection, consumes connChannel and closes
+// all (presumably inbound) connections.
 func (c *Contact) contactConnection() {
 	// Signalled when the active connection is closed
 	connClosedChannel := make(chan struct{})
 	connectionsEnabled := false
+	// Set while an outbound connection attempt is running
+	var outboundCancel context.CancelFunc
+	stopOutbound := func() {
+		if outboundCancel != nil {
+			outboundCancel()
+			outboundCancel = nil
+			c.setOutboundState(context.Background(), outboundIdle)
+		}
+	}
+	closeConnection := func() {
+		c.mutex.Lock()
+		if c.connection != nil {
+			c.connection.Conn.Close()
+			c.connection = nil
+			c.onConnectionStateChanged()
+			c.mutex.Unlock()
+			<-connClosedChannel
+		} else {
+			c.mutex.Unlock()
+		}
+	}
 
+loop:
 	for {
 		if !connectionsEnabled {
 			// Reject all connections on connChannel and wait for start signal
@@ -198,31 +294,39 @@ func (c *Contact) contactConnection() {
 					// auth means they'll never try again. Both are sometimes wrong. Hmm.
 				}
 			case enable := <-c.connEnabledSignal:
-				if enable {
+				if enable && !c.IsHidden() {
 					log.Printf("Contact %s connections are enabled", c.Address())
 					connectionsEnabled = true
 				}
-				// XXX hard kill
+			case <-c.destroyed:
+				break loop
 			}
 			continue
 		}
 
 		// If there is no active connection, spawn an outbound connector. A successful connection
 		// is returned via connChannel, and otherwise it will keep trying until cancelled via
-		// the context.
-		var outboundCtx context.Context
-		outboundCancel := func() {}
-		if c.connection == nil && c.shouldMakeOutboundConnections() {
+		// the context. The attempt continues if an inbound connection is discarded.
+		if outboundCancel == nil && c.connection == nil && c.shouldMakeOutboundConnections() {
+			var outboundCtx context.Context
 			outboundCtx, outboundCancel = context.WithCancel(context.Background())
-			go c.connectOutbound(outboundCtx, c.connChannel)
+			c.setOutboundState(outboundCtx, outboundConnecting)
+			c.outboundWait.Add(1)
+			go func() {
+				defer c.outboundWait.Done()
+				c.connectOutbound(outboundCtx, c.connChannel)
+			}()
 		}
 
 		select {
 		case conn := <-c.connChannel:
-			outboundCancel()
 			if conn == nil {
 				// Signal used to restart outbound connection attempts
+				stopOutbound()
 				continue
+			} else if !conn.IsInbound {
+				// The outbound attempt has finished
+				stopOutbound()
 			}
 
 			c.mutex.Lock()
@@ -237,6 +341,12 @@ func (c *Contact) contactConnection() {
 			}
 			replacingConn := c.connection != nil
 			c.connection = conn
+			if outboundCancel != nil {
+				// Mutex is held, so stopOutbound can't be used
+				outboundCancel()
+				outboundCancel = nil
+				c.outbound = outboundIdle
+			}
 			if replacingConn {
 				// Wait for old handleConnection to return
 				c.mutex.Unlock()
@@ -248,32 +358,33 @@ func (c *Contact) contactConnection() {
 			c.mutex.Unlock()
 
 		case <-connClosedChannel:
-			outboundCancel()
+			stopOutbound()
 			c.mutex.Lock()
 			c.connection = nil
 			c.onConnectionStateChanged()
 			c.mutex.Unlock()
 
 		case enable := <-c.connEnabledSignal:
-			outboundCancel()
-			if !enable {
+			stopOutbound()
+			if !enable || c.IsHidden() {
 				connectionsEnabled = false
 				log.Printf("Contact %s connections are disabled", c.Address())
+				closeConnection()
 			}
+
+		case <-c.destroyed:
+			stopOutbound()
+			break loop
 		}
 	}
 
 	log.Printf("Exiting contact connection loop for %s", c.Address())
-	c.mutex.Lock()
-	if c.connection != nil {
-		c.connection.Conn.Close()
-		c.connection = nil
-		c.onConnectionStateChanged()
-		c.mutex.Unlock()
-		<-connClosedChannel
-	} else {
-		c.mutex.Unlock()
-	}
+	closeConnection()
+
+	// Outbound attempts are cancelled, and any connection they return is
+	// closed by AssignConnection.
+	c.outboundWait.Wait()
+	close(c.connectionDone)
 }
 
 // Goroutine to maintain an open contact connection, calls Process and reports when closed.
@@ -298,32 +409,69 @@ func (c *Contact) handleConnection(conn *connection.Connection, closedChannel ch
 // been cancelled.
 func (c *Contact) connectOutbound(ctx context.Context, connChannel chan *connection.Connection) {
 	c.mutex.Lock()
+	core := c.core
 	connector := OnionConnector{
-		Network:     c.core.Network,
+		Network:     core.Network,
 		NeverGiveUp: true,
+		BackoffDelays: func() []uint32 {
+			return settingsReconnectDelays(core.Settings())
+		},
 	}
 	hostname, _ := OnionFromAddress(c.data.Address)
 	isRequest := c.data.Request != nil
+	// Requests are only attempted until they expire; requestCtx is cancelled
+	// at that time, as well as with ctx.
+	requestCtx := ctx
+	if isRequest {
+		var cancel context.CancelFunc
+		requestCtx, cancel = context.WithDeadline(ctx, outboundRequestExpires(c.data.Request, core.Settings()))
+		defer cancel()
+	}
 	c.mutex.Unlock()
 
+	// Stop connecting to a contact that has rejected or removed us. The nil
+	// connection returns the connection loop to waiting until a request is
+	// sent again.
+	stopConnecting := func() {
+		select {
+		case connChannel <- nil:
+		case <-ctx.Done():
+		}
+	}
+
 	for {
-		conn, err := connector.Connect(hostname+":9878", ctx)
+		conn, err := connector.Connect(hostname+":9878", requestCtx)
 		if err != nil {
 			// The only failure here should be context, because NeverGiveUp
 			// is set, but be robust anyway.
 			if ctx.Err() != nil {
 				return
+			} else if requestCtx.Err() != nil {
+				log.Printf("Outbound contact request to %v expired", c)
+				c.UpdateContactRequest("Expired")
+				stopConnecting()
+				return
 			}
 
 			log.Printf("Contact connection failure: %s", err)
 			continue
 		}
 
-		// XXX-protocol Ideally this should all take place under ctx also; easy option is a goroutine
-		// blocked on ctx that kills the connection.
+		// Negotiation and authentication don't use ctx, so the connection is
+		// closed if ctx is cancelled before they finish.
 		log.Printf("Successful outbound connection to contact %s", hostname)
+		handshakeDone := make(chan struct{})
+		go func() {
+			select {
+			case <-ctx.Done():
+				conn.Close()
+			case <-handshakeDone:
+			}
+		}()
+
 		oc, err := protocol.NegotiateVersionOutbound(conn, hostname[0:16])
 		if err != nil {
+			close(handshakeDone)
 			log.Printf("Outbound connection version negotiation failed: %v", err)
 			conn.Close()
 			if err := connector.Backoff(ctx); err != nil {
@@ -334,10 +482,16 @@ func (c *Contact) connectOutbound(ctx context.Context, connChannel chan *connect
 
 		log.Printf("Outbound connection negotiated version; authenticating")
 		privateKey := c.core.Identity.PrivateKey()
+		c.setOutboundState(ctx, outboundAuthenticating)
 		known, err := connection.HandleOutboundConnection(oc).ProcessAuthAsClient(&privateKey)
-		if err != nil {
+		close(handshakeDone)
+		if ctx.Err() != nil {
+			closeUnhandledConnection(oc)
+			return
+		} else if err != nil {
 			log.Printf("Outbound connection authentication failed: %v", err)
 			closeUnhandledConnection(oc)
+			c.setOutboundState(ctx, outboundConnecting)
 			if err := connector.Backoff(ctx); err != nil {
 				return
 			}
@@ -346,12 +500,10 @@ func (c *Contact) connectOutbound(ctx context.Context, connChannel chan *connect
 
 		if !known && !isRequest {
 			log.Printf("Outbound connection to contact says we are not a known contact for %v", c)
-			// XXX Should move to rejected status, stop attempting connections.
 			closeUnhandledConnection(oc)
-			if err := connector.Backoff(ctx); err != nil {
-				return
-			}
-			continue
+			c.removedByPeer()
+			stopConnecting()
+			return
 		} else if known && isRequest {
 			log.Printf("Contact request implicitly accepted for outbound connection by contact %v", c)
 			c.UpdateContactRequest("Accepted")
@@ -361,8 +513,20 @@ func (c *Contact) connectOutbound(ctx context.Context, connChannel chan *connect
 		if isRequest {
 			// Need to send a contact request; this will block until the peer accepts or rejects,
 			// the connection fails, or the context is cancelled (which also closes the connection).
-			if err := c.sendContactRequest(oc, ctx); err != nil {
+			if err := c.sendContactRequest(oc, requestCtx); err != nil {
 				log.Printf("Outbound contact request connection closed: %s", err)
+				if ctx.Err() != nil {
+					return
+				} else if requestCtx.Err() != nil {
+					log.Printf("Outbound contact request to %v expired", c)
+					c.UpdateContactRequest("Expired")
+					stopConnecting()
+					return
+				} else if c.Status() == ricochet.Contact_REJECTED {
+					log.Printf("Outbound contact request to %v was not accepted; not sending again", c)
+					stopConnecting()
+					return
+				}
 				if err := connector.Backoff(ctx); err != nil {
 					return
 				}
@@ -378,6 +542,17 @@ func (c *Contact) connectOutbound(ctx context.Context, connChannel chan *connect
 	}
 }
 
+// setOutboundState records the progress of the outbound attempt using ctx. The
+// connection loop resets it to idle after cancelling the attempt, so a
+// cancelled attempt can't change it.
+func (c *Contact) setOutboundState(ctx context.Context, state outboundState) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if ctx.Err() == nil {
+		c.outbound = state
+	}
+}
+
 type requestChannelHandler struct {
 	Response chan string
 }
@@ -404,18 +579,27 @@ func (c *Contact) sendContactRequest(conn *connection.Connection, ctx context.Co
 	processChan := make(chan error)
 	responseChan := make(chan string)
 
-	// No timeouts on outbound contact request; wait forever for a final reply
+	// The request may have been edited since the connection started
+	c.mutex.Lock()
+	if c.data.Request == nil {
+		c.mutex.Unlock()
+		closeUnhandledConnection(conn)
+		return errors.New("No contact request to send")
+	}
+	requestChannel := &channels.ContactRequestChannel{
+		Handler: &requestChannelHandler{Response: responseChan},
+		Name:    c.data.Request.FromNickname,
+		Message: c.data.Request.Text,
+	}
+	c.mutex.Unlock()
+
+	// No timeouts on outbound contact request; wait for a final reply until ctx is done
 	go func() {
 		processChan <- conn.Process(ach)
 	}()
 
 	err := conn.Do(func() error {
-		_, err := conn.RequestOpenChannel("im.ricochet.contact.request",
-			&channels.ContactRequestChannel{
-				Handler: &requestChannelHandler{Response: responseChan},
-				Name:    c.data.Request.FromNickname, // XXX mutex
-				Message: c.data.Request.Text,
-			})
+		_, err := conn.RequestOpenChannel("im.ricochet.contact.request", requestChannel)
 		return err
 	})
 	if err != nil {
@@ -493,9 +677,14 @@ func (c *Contact) considerUsingConnection(conn *connection.Connection) error {
 
 	// If this connection is inbound and there's an outbound attempt, keep this
 	// connection and cancel outbound if we haven't sent authentication yet, or
-	// if the outbound connection will lose the fallback comparison above.
-	// XXX implement this; currently outbound is always cancelled when an inbound
-	// connection succeeds.
+	// if the outbound connection will lose the fallback comparison above. Inbound
+	// connections always accept an outbound contact request, so they're kept.
+	if c.connection == nil && conn.IsInbound && c.data.Request == nil {
+		myHostname, _ := PlainHostFromAddress(c.core.Identity.Address())
+		if !shouldKeepInbound(c.outbound, preferOutbound(myHostname, conn.RemoteHostname)) {
+			return fmt.Errorf("Using outbound connection attempt according to fallback order")
+		}
+	}
 
 	// We will keep conn, close c.connection instead if there was one
 	killConn = c.connection
@@ -525,9 +714,13 @@ func (c *Contact) onConnectionStateChanged() {
 	c.timeConnected = time.Now()
 	c.data.LastConnected = c.timeConnected.Format(time.RFC3339)
 
-	config := c.core.Config.Lock()
-	config.Contacts[c.data.Address] = c.data
-	c.core.Config.Unlock()
+	if !c.removed {
+		config := c.core.Config.Lock()
+		config.Contacts[c.data.Address] = c.data
+		recordConnectionState(config, c.data.Address, c.connection != nil,
+			c.connection != nil && c.connection.IsInbound, c.timeConnected)
+		c.core.Config.Unlock()
+	}
 
 	// XXX I wonder if events and config updates can be combined now, and made safer...
 	// _really_ assumes c.mutex was held
@@ -554,28 +747,19 @@ func (c *Contact) onConnectionStateChanged() {
 // Decide whether to replace the existing connection with conn.
 // Assumes mutex is held.
 func (c *Contact) shouldReplaceConnection(conn *connection.Connection) bool {
-	myHostname, _ := PlainHostFromAddress(c.core.Identity.Address())
 	if c.connection == nil {
 		return true
-	} else if c.connection.IsInbound == conn.IsInbound {
-		// If the existing connection is in the same direction, always use the new one
-		log.Printf("Replacing existing same-direction connection %v with new connection %v for contact %v", c.connection, conn, c)
-		return true
-	} else if time.Since(c.timeConnected) > (30 * time.Second) {
-		// If the existing connection is more than 30 seconds old, use the new one
-		log.Printf("Replacing existing %v old connection %v with new connection %v for contact %v", time.Since(c.timeConnected), c.connection, conn, c)
-		return true
-	} else if preferOutbound := myHostname < conn.RemoteHostname; preferOutbound != conn.IsInbound {
-		// Fall back to string comparison of hostnames for a stable resolution
-		// New connection wins
-		log.Printf("Replacing existing connection %v with new connection %v for contact %v according to fallback order", c.connection, conn, c)
+	}
+
+	myHostname, _ := PlainHostFromAddress(c.core.Identity.Address())
+	age := time.Since(c.timeConnected)
+	if shouldReplaceConnection(c.connection.IsInbound, age, conn.IsInbound, preferOutbound(myHostname, conn.RemoteHostname)) {
+		log.Printf("Replacing existing %v old connection %v with new connection %v for contact %v", age, c.connection, conn, c)
 		return true
 	} else {
-		// Old connection wins fallback
 		log.Printf("Keeping existing connection %v instead of new connection %v for contact %v according to fallback order", c.connection, conn, c)
 		return false
 	}
-	return false
 }
 
 // Update the status of a contact request from a protocol event. Returns
@@ -593,7 +777,7 @@ func (c *Contact) UpdateContactRequest(status string) bool {
 	event := ricochet.ContactEvent{
 		Type: ricochet.ContactEvent_UPDATE,
 		Subject: &ricochet.ContactEvent_Contact{
-			Contact: c.Data(),
+			Contact: proto.Clone(c.data).(*ricochet.Contact),
 		},
 	}
 	c.events.Publish(event)
@@ -622,29 +806,122 @@ func (c *Contact) updateContactRequest(status string) bool {
 		}
 
 	case "Rejected":
+		c.data.Request.Rejected = true
 		c.data.Request.WhenRejected = now
+		c.data.Status = ricochet.Contact_REJECTED
 
 	case "Error":
+		c.data.Request.Rejected = true
 		c.data.Request.WhenRejected = now
 		c.data.Request.RemoteError = "error occurred"
+		c.data.Status = ricochet.Contact_REJECTED
+
+	case "Expired":
+		c.data.Request.Rejected = true
+		c.data.Request.Expired = true
+		c.data.Request.WhenRejected = now
+		c.data.Status = ricochet.Contact_REJECTED
 
 	default:
 		log.Printf("Unknown contact request status '%s'", status)
 	}
 
-	config := c.core.Config.Lock()
-	defer c.core.Config.Unlock()
-	config.Contacts[c.data.Address] = c.data
+	if !c.removed {
+		config := c.core.Config.Lock()
+		config.Contacts[c.data.Address] = c.data
+		c.core.Config.Unlock()
+	}
 	return re
 }
 
+// mutableContactFields are the fields of Contact that can be changed by users,
+// with functions to copy each field from src to dst. Validation is done by
+// ContactList.UpdateContact.
+var mutableContactFields = map[string]func(dst, src *ricochet.Contact){
+	"nickname": func(dst, src *ricochet.Contact) { dst.Nickname = src.Nickname },
+	"tags":     func(dst, src *ricochet.Contact) { dst.Tags = append([]string(nil), src.Tags...) },
+	"notes":    func(dst, src *ricochet.Contact) { dst.Notes = src.Notes },
+	"metadata": func(dst, src *ricochet.Contact) { dst.Metadata = copyMetadata(src.Metadata) },
+	"hidden":   func(dst, src *ricochet.Contact) { dst.Hidden = src.Hidden },
+}
+
+// setFields copies the named mutable fields from data, saves them to the
+// config, and publishes an UPDATE event if anything changed. Fields must be
+// validated by the caller. If this returns true, the hidden state changed,
+// and the caller must call refreshConnectionState.
+func (c *Contact) setFields(data *ricochet.Contact, fields []string) (bool, error) {
+	wasHidden := c.IsHidden()
+	err := c.update(func(newData *ricochet.Contact) {
+		for _, field := range fields {
+			mutableContactFields[field](newData, data)
+		}
+	})
+	return c.IsHidden() != wasHidden, err
+}
+
+// update calls modify with a copy of the contact's data, then saves the
+// changes to the config and publishes an UPDATE event if anything changed.
+func (c *Contact) update(modify func(data *ricochet.Contact)) error {
+	c.mutex.Lock()
+	newData := proto.Clone(c.data).(*ricochet.Contact)
+	modify(newData)
+	if proto.Equal(c.data, newData) {
+		c.mutex.Unlock()
+		return nil
+	} else if c.removed {
+		c.mutex.Unlock()
+		return errors.New("Contact was removed")
+	}
+
+	config := c.core.Config.Lock()
+	config.Contacts[newData.Address] = newData
+	if err := c.core.Config.Unlock(); err != nil {
+		config := c.core.Config.Lock()
+		config.Contacts[c.data.Address] = c.data
+		c.core.Config.Unlock()
+		c.mutex.Unlock()
+		return err
+	}
+	c.data = newData
+	c.mutex.Unlock()
+
+	event := ricochet.ContactEvent{
+		Type: ricochet.ContactEvent_UPDATE,
+		Subject: &ricochet.ContactEvent_Contact{
+			Contact: c.Data(),
+		},
+	}
+	c.events.Publish(event)
+	return nil
+}
+
+// reloadConfig applies the user-editable fields from a reloaded configuration,
+// publishing an UPDATE event if anything changed.
+func (c *Contact) reloadConfig(data *ricochet.Contact) {
+	fields := make([]string, 0, len(mutableContactFields))
+	for field := range mutableContactFields {
+		fields = append(fields, field)
+	}
+	hiddenChanged, err := c.setFields(data, fields)
+	if err != nil {
+		log.Printf("Applying reloaded config for contact %s failed: %v", data.Address, err)
+	}
+	if hiddenChanged {
+		c.refreshConnectionState()
+	}
+}
+
 // AssignConnection takes new connections, inbound or outbound, to this contact, and
 // asynchronously decides whether to keep or close them.
 func (c *Contact) AssignConnection(conn *connection.Connection) {
-	c.connectionOnce.Do(func() {
-		go c.contactConnection()
-	})
+	c.startConnectionLoop()
 
 	// If connections are disabled, this connection will be closed by contactConnection
-	c.connChannel <- conn
+	select {
+	case c.connChannel <- conn:
+	case <-c.destroyed:
+		if conn != nil {
+			go closeUnhandledConnection(conn)
+		}
+	}
 }
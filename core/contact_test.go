@@ -0,0 +1,191 @@
+package core
+
+import (
+	"github.com/ricochet-im/ricochet-go/core/config"
+	"github.com/ricochet-im/ricochet-go/core/utils"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	connection "github.com/s-rah/go-ricochet/connection"
+	"io"
+	"io/ioutil"
+	"net"
+	"runtime"
+	"testing"
+	"time"
+)
+
+const (
+	testIdentityAddress = "ricochet:bbbbbbbbbbbbbbbb"
+	testContactAddress  = "ricochet:aaaaaaaaaaaaaaaa"
+)
+
+// newTestCore returns a core with an in-memory config and an empty contact
+// list, which doesn't use the network.
+func newTestCore(t *testing.T) *Ricochet {
+	conf, err := config.NewConfig(config.NewMemoryStorage())
+	if err != nil {
+		t.Fatalf("Creating config failed: %v", err)
+	}
+	core := &Ricochet{
+		Config:  conf,
+		Network: CreateNetwork(),
+	}
+	core.Identity = &Identity{
+		core:               core,
+		address:            testIdentityAddress,
+		ConversationStream: utils.CreatePublisher(),
+	}
+	core.Identity.contactList, err = LoadContactList(core)
+	if err != nil {
+		t.Fatalf("Loading contact list failed: %v", err)
+	}
+	return core
+}
+
+// connectTestContact gives contact an authenticated inbound connection, and
+// waits until the contact is using it. The other end of the connection is
+// read until it's closed.
+func connectTestContact(t *testing.T, contact *Contact) {
+	local, remote := net.Pipe()
+	go io.Copy(ioutil.Discard, remote)
+
+	conn := connection.NewInboundConnection(local)
+	conn.RemoteHostname, _ = PlainHostFromAddress(contact.Address())
+	conn.Authentication["im.ricochet.auth.hidden-service"] = true
+	contact.AssignConnection(conn)
+
+	for deadline := time.Now().Add(5 * time.Second); contact.Connection() != conn; {
+		if time.Now().After(deadline) {
+			t.Fatal("Contact did not use the connection")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// waitForGoroutines waits until there are no more than n goroutines, and
+// returns the number that are left.
+func waitForGoroutines(n int) int {
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		count := runtime.NumGoroutine()
+		if count <= n || time.Now().After(deadline) {
+			return count
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestRemoveConnectedContact(t *testing.T) {
+	core := newTestCore(t)
+	cl := core.Identity.ContactList()
+	baseline := runtime.NumGoroutine()
+
+	contact, err := cl.AddNewContact(&ricochet.Contact{
+		Address:  testContactAddress,
+		Nickname: "Alice",
+	})
+	if err != nil {
+		t.Fatalf("Adding contact failed: %v", err)
+	}
+	connectTestContact(t, contact)
+	if contact.Status() != ricochet.Contact_ONLINE {
+		t.Errorf("Contact is %s with a connection, expected ONLINE", contact.Status())
+	}
+
+	if err := cl.RemoveContact(contact); err != nil {
+		t.Fatalf("Removing contact failed: %v", err)
+	}
+	if contact.Connection() != nil {
+		t.Error("Contact still has a connection after it was removed")
+	}
+	if count := waitForGoroutines(baseline); count > baseline {
+		buf := make([]byte, 1<<16)
+		t.Errorf("%d goroutines are left after removing the contact, expected %d\n%s",
+			count, baseline, buf[:runtime.Stack(buf, true)])
+	}
+	if cl.ContactByAddress(testContactAddress) != nil {
+		t.Error("Contact is still in the list after it was removed")
+	}
+	if core.Config.Read().Contacts[testContactAddress] != nil {
+		t.Error("Contact is still in the config after it was removed")
+	}
+
+	// The same address can be added again, and isn't affected by the old contact
+	contact, err = cl.AddNewContact(&ricochet.Contact{
+		Address:  testContactAddress,
+		Nickname: "Alice",
+	})
+	if err != nil {
+		t.Fatalf("Adding contact again failed: %v", err)
+	}
+	connectTestContact(t, contact)
+	if data := core.Config.Read().Contacts[testContactAddress]; data == nil || data.Nickname != "Alice" {
+		t.Errorf("Contact added again was not saved: %v", data)
+	}
+
+	if err := cl.RemoveContact(contact); err != nil {
+		t.Fatalf("Removing contact again failed: %v", err)
+	}
+	if count := waitForGoroutines(baseline); count > baseline {
+		t.Errorf("%d goroutines are left after removing the contact again, expected %d", count, baseline)
+	}
+}
+
+func TestDestroyUnstartedContact(t *testing.T) {
+	core := newTestCore(t)
+	contact, err := ContactFromConfig(core, &ricochet.Contact{
+		Address:  testContactAddress,
+		Nickname: "Alice",
+	}, utils.CreatePublisher())
+	if err != nil {
+		t.Fatalf("Creating contact failed: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		contact.Destroy()
+		contact.Destroy()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Destroy did not return for a contact without a connection loop")
+	}
+}
+
+func TestHideConnectedContact(t *testing.T) {
+	core := newTestCore(t)
+	cl := core.Identity.ContactList()
+	contact, err := cl.AddNewContact(&ricochet.Contact{
+		Address:  testContactAddress,
+		Nickname: "Alice",
+	})
+	if err != nil {
+		t.Fatalf("Adding contact failed: %v", err)
+	}
+	defer cl.RemoveContact(contact)
+	connectTestContact(t, contact)
+
+	// Connections are enabled and disabled while the contact is hidden
+	done := make(chan struct{})
+	go func() {
+		cl.StopConnections()
+		cl.StartConnections()
+		close(done)
+	}()
+
+	data := &ricochet.Contact{Address: testContactAddress, Hidden: true}
+	if _, err := cl.UpdateContact(data, []string{"hidden"}); err != nil {
+		t.Fatalf("Hiding contact failed: %v", err)
+	}
+	<-done
+	if contact.Connection() != nil {
+		t.Error("Hidden contact still has a connection")
+	}
+
+	data.Hidden = false
+	if _, err := cl.UpdateContact(data, []string{"hidden"}); err != nil {
+		t.Fatalf("Showing contact failed: %v", err)
+	}
+	connectTestContact(t, contact)
+}
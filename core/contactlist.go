@@ -3,8 +3,11 @@ package core
 import (
 	"errors"
 	"fmt"
+	"github.com/golang/protobuf/proto"
 	"github.com/ricochet-im/ricochet-go/core/utils"
 	"github.com/ricochet-im/ricochet-go/rpc"
+	"log"
+	"reflect"
 	"sync"
 	"time"
 )
@@ -17,6 +20,7 @@ type ContactList struct {
 
 	contacts        map[string]*Contact
 	inboundRequests map[string]*InboundContactRequest
+	requestLimiter  *requestLimiter
 }
 
 func LoadContactList(core *Ricochet) (*ContactList, error) {
@@ -24,6 +28,7 @@ func LoadContactList(core *Ricochet) (*ContactList, error) {
 		core:            core,
 		events:          utils.CreatePublisher(),
 		inboundRequests: make(map[string]*InboundContactRequest),
+		requestLimiter:  newRequestLimiter(core.Settings),
 	}
 
 	config := core.Config.Read()
@@ -43,6 +48,25 @@ func LoadContactList(core *Ricochet) (*ContactList, error) {
 		list.contacts[addr] = contact
 	}
 
+	for addr, data := range config.InboundRequests {
+		if addr != data.Address {
+			return nil, fmt.Errorf("Inbound contact request address/key do not match ('%s' and '%s')", addr, data.Address)
+		}
+		if list.contacts[addr] != nil {
+			log.Printf("Ignoring inbound contact request from existing contact %s", addr)
+			continue
+		}
+
+		request, err := inboundContactRequestFromConfig(core, data)
+		if err != nil {
+			return nil, err
+		}
+		request.StatusChanged = list.inboundRequestChanged
+		list.inboundRequests[addr] = request
+	}
+	list.expireInboundRequests()
+	list.markInterruptedConnections()
+
 	return list, nil
 }
 
@@ -66,6 +90,18 @@ func (cl *ContactList) ContactByAddress(address string) *Contact {
 	return cl.contacts[address]
 }
 
+// InboundRequests returns all inbound contact requests, including rejected
+// requests that have not expired.
+func (cl *ContactList) InboundRequests() []*InboundContactRequest {
+	cl.mutex.RLock()
+	defer cl.mutex.RUnlock()
+	re := make([]*InboundContactRequest, 0, len(cl.inboundRequests))
+	for _, request := range cl.inboundRequests {
+		re = append(re, request)
+	}
+	return re
+}
+
 func (cl *ContactList) InboundRequestByAddress(address string) *InboundContactRequest {
 	cl.mutex.RLock()
 	defer cl.mutex.RUnlock()
@@ -82,14 +118,32 @@ func (cl *ContactList) InboundRequestByAddress(address string) *InboundContactRe
 func (this *ContactList) AddNewContact(data *ricochet.Contact) (*Contact, error) {
 	this.mutex.Lock()
 	defer this.mutex.Unlock()
+	return this.addNewContact(data)
+}
+
+// addAcceptedContact adds a contact for an accepted inbound request. If another
+// contact has a nickname that looks like the requested one, a number is added
+// to make it distinct instead of failing.
+func (this *ContactList) addAcceptedContact(data *ricochet.Contact) (*Contact, error) {
+	this.mutex.Lock()
+	defer this.mutex.Unlock()
+	if nickname := this.availableNickname(data.Nickname); nickname != data.Nickname {
+		log.Printf("Nickname '%s' for %s is already used; using '%s' instead", data.Nickname, data.Address, nickname)
+		data.Nickname = nickname
+	}
+	return this.addNewContact(data)
+}
 
+// addNewContact is AddNewContact, but assumes the mutex is held.
+func (this *ContactList) addNewContact(data *ricochet.Contact) (*Contact, error) {
 	if this.contacts[data.Address] != nil {
 		return nil, errors.New("Contact already exists with this address")
 	}
-	for _, contact := range this.contacts {
-		if contact.Nickname() == data.Nickname {
-			return nil, errors.New("Contact already exists with this nickname")
-		}
+	if this.IsBlocked(data.Address) {
+		return nil, errors.New("Address is blocked")
+	}
+	if this.contactWithNickname(data.Nickname, nil) != nil {
+		return nil, errors.New("Contact already exists with this nickname")
 	}
 
 	// XXX check inbound requests (but this can be called for an inbound req too)
@@ -100,7 +154,13 @@ func (this *ContactList) AddNewContact(data *ricochet.Contact) (*Contact, error)
 		config.Contacts = make(map[string]*ricochet.Contact)
 	}
 	config.Contacts[data.Address] = data
-	this.core.Config.Unlock()
+	if err := this.core.Config.Unlock(); err != nil {
+		// Don't keep a contact that the user believes wasn't added
+		config := this.core.Config.Lock()
+		delete(config.Contacts, data.Address)
+		this.core.Config.Unlock()
+		return nil, err
+	}
 
 	// Create Contact
 	contact, err := ContactFromConfig(this.core, data, this.events)
@@ -118,6 +178,7 @@ func (this *ContactList) AddNewContact(data *ricochet.Contact) (*Contact, error)
 	this.events.Publish(event)
 
 	// XXX Should this be here? Is it ok for inbound where we might pass conn over momentarily?
+	// The new contact's connection loop is idle, so this doesn't wait with the mutex held
 	contact.StartConnection()
 	return contact, nil
 }
@@ -128,9 +189,21 @@ func (this *ContactList) AddNewContact(data *ricochet.Contact) (*Contact, error)
 // If an inbound request already exists for this address, that request will be automatically
 // accepted, and the returned contact will already be fully established.
 func (cl *ContactList) AddContactRequest(address, name, fromName, text string) (*Contact, error) {
+	data, err := newContactRequestData(address, name, fromName, text)
+	if err != nil {
+		return nil, err
+	}
+	return cl.addContactRequest(data)
+}
+
+// newContactRequestData validates the parameters of an outbound contact
+// request, and returns the data for a new contact with that request.
+func newContactRequestData(address, name, fromName, text string) (*ricochet.Contact, error) {
 	if !IsAddressValid(address) {
 		return nil, errors.New("Invalid ricochet address")
 	}
+	name = NormalizeNickname(name)
+	fromName = NormalizeNickname(fromName)
 	if !IsNicknameAcceptable(name) {
 		return nil, errors.New("Invalid nickname")
 	}
@@ -141,7 +214,7 @@ func (cl *ContactList) AddContactRequest(address, name, fromName, text string) (
 		return nil, errors.New("Invalid message")
 	}
 
-	data := &ricochet.Contact{
+	return &ricochet.Contact{
 		Address:     address,
 		Nickname:    name,
 		WhenCreated: time.Now().Format(time.RFC3339),
@@ -153,13 +226,18 @@ func (cl *ContactList) AddContactRequest(address, name, fromName, text string) (
 			Text:         text,
 			WhenCreated:  time.Now().Format(time.RFC3339),
 		},
-	}
+	}, nil
+}
+
+// addContactRequest is AddContactRequest with data from newContactRequestData,
+// to which other validated fields of the contact may be added.
+func (cl *ContactList) addContactRequest(data *ricochet.Contact) (*Contact, error) {
 	contact, err := cl.AddNewContact(data)
 	if err != nil {
 		return nil, err
 	}
 
-	if inboundRequest := cl.InboundRequestByAddress(address); inboundRequest != nil {
+	if inboundRequest := cl.InboundRequestByAddress(data.Address); inboundRequest != nil {
 		contact.UpdateContactRequest("Accepted")
 		inboundRequest.AcceptWithContact(contact)
 	}
@@ -167,22 +245,114 @@ func (cl *ContactList) AddContactRequest(address, name, fromName, text string) (
 	return contact, nil
 }
 
+// UpdateContact changes the named fields of an existing contact to the values
+// in data, which must have the address of the contact. Fields are named as in
+// the JSON encoding of Contact; see mutableContactFields. Other fields of data
+// are ignored. The change is saved and an UPDATE event is published.
+func (cl *ContactList) UpdateContact(data *ricochet.Contact, fields []string) (*Contact, error) {
+	contact, hiddenChanged, err := cl.updateContact(data, fields)
+	if hiddenChanged {
+		// Waits for the connection loop, which can't happen with the mutex held
+		contact.refreshConnectionState()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return contact, nil
+}
+
+// updateContact is UpdateContact, but leaves it to the caller to refresh the
+// connection state if the hidden state changed.
+func (cl *ContactList) updateContact(data *ricochet.Contact, fields []string) (*Contact, bool, error) {
+	cl.mutex.Lock()
+	defer cl.mutex.Unlock()
+
+	contact := cl.contacts[data.Address]
+	if contact == nil {
+		return nil, false, errors.New("Contact does not exist")
+	}
+	if len(fields) == 0 {
+		return nil, false, errors.New("No fields to update")
+	}
+
+	for _, field := range fields {
+		if _, ok := mutableContactFields[field]; !ok {
+			if isContactField(field) {
+				return nil, false, fmt.Errorf("Contact field '%s' cannot be changed", field)
+			}
+			return nil, false, fmt.Errorf("Unknown contact field '%s'", field)
+		}
+	}
+
+	data = proto.Clone(data).(*ricochet.Contact)
+	for _, field := range fields {
+		switch field {
+		case "nickname":
+			data.Nickname = NormalizeNickname(data.Nickname)
+			if !IsNicknameAcceptable(data.Nickname) {
+				return nil, false, errors.New("Invalid nickname")
+			}
+			if cl.contactWithNickname(data.Nickname, contact) != nil {
+				return nil, false, errors.New("Contact already exists with this nickname")
+			}
+		case "tags":
+			tags, err := normalizeTags(data.Tags)
+			if err != nil {
+				return nil, false, err
+			}
+			data.Tags = tags
+		case "notes":
+			if err := validateNotes(data.Notes); err != nil {
+				return nil, false, err
+			}
+		case "metadata":
+			if err := validateMetadata(data.Metadata); err != nil {
+				return nil, false, err
+			}
+		}
+	}
+
+	hiddenChanged, err := contact.setFields(data, fields)
+	return contact, hiddenChanged, err
+}
+
+func isContactField(name string) bool {
+	properties := proto.GetProperties(reflect.TypeOf(ricochet.Contact{}))
+	for _, prop := range properties.Prop {
+		if prop.OrigName == name {
+			return true
+		}
+	}
+	return false
+}
+
 func (this *ContactList) RemoveContact(contact *Contact) error {
 	this.mutex.Lock()
-	defer this.mutex.Unlock()
-
 	address := contact.Address()
 	if this.contacts[address] != contact {
+		this.mutex.Unlock()
 		return errors.New("Not in contact list")
 	}
+	this.removeContact(contact)
+	this.mutex.Unlock()
 
-	// XXX How do we safely make sure that the contact has stopped everything, and that
-	// nobody is going to block on it or keep referencing it..? This is insufficient, it
-	// leaves a goroutine up among other things.
-	contact.StopConnection()
+	contact.Destroy()
+	return nil
+}
+
+// removeContact removes a contact from the list and config, and publishes a
+// DELETE event. Assumes the mutex is held. The caller must Destroy the contact
+// after unlocking the mutex, because waiting for its connections to stop
+// would block the contact list.
+func (this *ContactList) removeContact(contact *Contact) {
+	address := contact.Address()
+
+	// Connections may still change the contact until they have stopped
+	contact.markRemoved()
 
 	config := this.core.Config.Lock()
 	delete(config.Contacts, address)
+	delete(config.ContactHistory, address)
 	this.core.Config.Unlock()
 
 	delete(this.contacts, address)
@@ -196,8 +366,6 @@ func (this *ContactList) RemoveContact(contact *Contact) error {
 		},
 	}
 	this.events.Publish(event)
-
-	return nil
 }
 
 // AddOrUpdateInboundContactRequest creates or modifies an inbound contact request for
@@ -217,8 +385,26 @@ func (cl *ContactList) AddOrUpdateInboundContactRequest(address, nickname, messa
 	cl.mutex.Lock()
 	defer cl.mutex.Unlock()
 
+	cl.expireInboundRequests()
+
+	// Requests with a valid invite are accepted immediately, unless an existing
+	// contact or rejected request takes precedence
+	if request := cl.inboundRequests[address]; cl.contacts[address] == nil &&
+		(request == nil || !request.IsRejected()) {
+		if contact := cl.acceptInvite(address, nickname, message); contact != nil {
+			return nil, contact
+		}
+	}
+
+	similar := ""
+	if contact := cl.contactWithNickname(nickname, cl.contacts[address]); nickname != "" && contact != nil {
+		log.Printf("Contact request from %s has a nickname that looks like contact %s", address, contact.Address())
+		similar = contact.Address()
+	}
+
 	// Look up existing request
 	if request := cl.inboundRequests[address]; request != nil {
+		request.setSimilarContact(similar)
 		// Errors in Update will change the state of the request, which the caller sends as a reply
 		request.Update(nickname, message)
 		return request, nil
@@ -234,12 +420,30 @@ func (cl *ContactList) AddOrUpdateInboundContactRequest(address, nickname, messa
 		}
 	}
 
+	pending := 0
+	for _, request := range cl.inboundRequests {
+		if !request.IsRejected() {
+			pending++
+		}
+	}
+	if !cl.requestLimiter.allowNewRequest(address, pending) {
+		return nil, nil
+	}
+
+	switch action, contact := cl.applyRequestPolicy(address, nickname, message); action {
+	case ricochet.RequestPolicyDecision_ACCEPTED:
+		return nil, contact
+	case ricochet.RequestPolicyDecision_REJECTED:
+		return nil, nil
+	}
+
 	// Create new request
 	request := CreateInboundContactRequest(cl.core, address, nickname, message)
+	request.setSimilarContact(similar)
 	request.StatusChanged = cl.inboundRequestChanged
 	cl.inboundRequests[address] = request
-	// XXX update config
 	requestData := request.Data()
+	cl.saveInboundRequest(&requestData)
 	event := ricochet.ContactEvent{
 		Type: ricochet.ContactEvent_ADD,
 		Subject: &ricochet.ContactEvent_Request{
@@ -250,6 +454,18 @@ func (cl *ContactList) AddOrUpdateInboundContactRequest(address, nickname, messa
 	return request, nil
 }
 
+// InboundRequestStats returns counters for inbound requests, including those
+// refused by the limits on request connections and pending requests.
+func (cl *ContactList) InboundRequestStats() ricochet.InboundRequestStats {
+	stats := cl.requestLimiter.Stats()
+	for _, request := range cl.InboundRequests() {
+		if !request.IsRejected() {
+			stats.PendingRequests++
+		}
+	}
+	return stats
+}
+
 // RemoveInboundContactRequest removes the record of an inbound contact request,
 // without taking any other actions. Generally you will want to act on a request with
 // Accept() or Reject(), rather than call this function directly, but it is valid to
@@ -263,9 +479,21 @@ func (cl *ContactList) RemoveInboundContactRequest(request *InboundContactReques
 		return errors.New("Request is not in contact list")
 	}
 
+	cl.removeInboundRequest(request)
+	return nil
+}
+
+// removeInboundRequest removes a request from the list and config, and
+// publishes a DELETE event. Assumes the mutex is held.
+func (cl *ContactList) removeInboundRequest(request *InboundContactRequest) {
+	requestData := request.Data()
+
 	// Close connection asynchronously to avoid potential deadlocking
 	go request.CloseConnection()
-	// XXX Remove from config
+
+	config := cl.core.Config.Lock()
+	delete(config.InboundRequests, requestData.Address)
+	cl.core.Config.Unlock()
 
 	delete(cl.inboundRequests, requestData.Address)
 
@@ -276,15 +504,36 @@ func (cl *ContactList) RemoveInboundContactRequest(request *InboundContactReques
 		},
 	}
 	cl.events.Publish(event)
-	return nil
+}
+
+// expireInboundRequests removes pending and rejected requests that are older
+// than the configured expiry. Assumes the mutex is held.
+func (cl *ContactList) expireInboundRequests() {
+	expiry := settingsRequestExpiry(cl.core.Settings())
+	for address, request := range cl.inboundRequests {
+		if request.isExpired(expiry) {
+			log.Printf("Inbound contact request from %s expired", address)
+			cl.removeInboundRequest(request)
+		}
+	}
+}
+
+// saveInboundRequest writes the state of an inbound request to the config
+func (cl *ContactList) saveInboundRequest(data *ricochet.ContactRequest) {
+	config := cl.core.Config.Lock()
+	if config.InboundRequests == nil {
+		config.InboundRequests = make(map[string]*ricochet.ContactRequest)
+	}
+	config.InboundRequests[data.Address] = data
+	cl.core.Config.Unlock()
 }
 
 // inboundRequestChanged is called by the StatusChanged callback of InboundContactRequest
 // after the request has been modified, such as through Update() or Reject(). Accepted
 // requests do not pass through this function, because they're immediately removed.
 func (cl *ContactList) inboundRequestChanged(request *InboundContactRequest) {
-	// XXX update config
 	requestData := request.Data()
+	cl.saveInboundRequest(&requestData)
 	event := ricochet.ContactEvent{
 		Type: ricochet.ContactEvent_UPDATE,
 		Subject: &ricochet.ContactEvent_Request{
@@ -294,6 +543,86 @@ func (cl *ContactList) inboundRequestChanged(request *InboundContactRequest) {
 	cl.events.Publish(event)
 }
 
+// mergeConfig validates the contacts of a reloaded configuration, and keeps
+// the fields of existing contacts that are managed by the backend rather than
+// by users, such as status and contact requests. Inbound requests, history,
+// request policy decisions, and introductions are always managed by the
+// backend and are not reloaded.
+func (cl *ContactList) mergeConfig(current, loaded *ricochet.Config) error {
+	loaded.InboundRequests = current.InboundRequests
+	loaded.ContactHistory = current.ContactHistory
+	loaded.RequestPolicyLog = current.RequestPolicyLog
+	loaded.Introductions = current.Introductions
+	if loaded.RequestPolicy != nil {
+		if err := validateRequestPolicy(loaded.RequestPolicy); err != nil {
+			return err
+		}
+	}
+
+	nicknames := make(map[string]bool, len(loaded.Contacts))
+	for address, data := range loaded.Contacts {
+		if data == nil || address != data.Address {
+			return fmt.Errorf("Contact address/key do not match for '%s'", address)
+		}
+		if !IsAddressValid(address) {
+			return fmt.Errorf("Invalid contact address '%s'", address)
+		}
+		if loaded.Blocked[address] != nil {
+			return fmt.Errorf("Contact %s is also blocked", address)
+		}
+		data.Nickname = NormalizeNickname(data.Nickname)
+		if !IsNicknameAcceptable(data.Nickname) {
+			return fmt.Errorf("Invalid nickname for contact %s", address)
+		}
+		skeleton := nicknameSkeleton(data.Nickname)
+		if nicknames[skeleton] {
+			return fmt.Errorf("Duplicate contact nickname '%s'", data.Nickname)
+		}
+		nicknames[skeleton] = true
+		tags, err := normalizeTags(data.Tags)
+		if err != nil {
+			return fmt.Errorf("%v for contact %s", err, address)
+		}
+		data.Tags = tags
+		if err := validateNotes(data.Notes); err != nil {
+			return fmt.Errorf("%v for contact %s", err, address)
+		}
+		if err := validateMetadata(data.Metadata); err != nil {
+			return fmt.Errorf("%v for contact %s", err, address)
+		}
+
+		if prev := current.Contacts[address]; prev != nil {
+			data.WhenCreated = prev.WhenCreated
+			data.LastConnected = prev.LastConnected
+			data.Request = prev.Request
+			data.Status = prev.Status
+		}
+	}
+
+	return nil
+}
+
+// applyConfig updates contacts to match a reloaded configuration that was
+// validated by mergeConfig, including adding and removing contacts.
+func (cl *ContactList) applyConfig(config *ricochet.Config) {
+	for _, contact := range cl.Contacts() {
+		if data := config.Contacts[contact.Address()]; data != nil {
+			contact.reloadConfig(data)
+		} else if err := cl.RemoveContact(contact); err != nil {
+			log.Printf("Removing contact from reloaded config failed: %v", err)
+		}
+	}
+
+	for address, data := range config.Contacts {
+		if cl.ContactByAddress(address) != nil {
+			continue
+		}
+		if _, err := cl.AddNewContact(proto.Clone(data).(*ricochet.Contact)); err != nil {
+			log.Printf("Adding contact from reloaded config failed: %v", err)
+		}
+	}
+}
+
 func (this *ContactList) StartConnections() {
 	for _, contact := range this.Contacts() {
 		contact.StartConnection()
@@ -0,0 +1,219 @@
+package core
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"golang.org/x/net/context"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// Most contacts that can be imported at once
+	maxImportRecords = 1000
+	// Time between contact requests sent by an import, unless it's given
+	defaultImportInterval = 10 * time.Second
+)
+
+// Columns of contact records in CSV, in order
+var contactRecordColumns = []string{"address", "nickname", "tags", "notes", "message"}
+
+// ExportContacts returns records for the contacts that are selected by
+// selector, or all contacts if it's nil, sorted by address.
+func (cl *ContactList) ExportContacts(selector *ContactSelector) []*ricochet.ContactRecord {
+	var re []*ricochet.ContactRecord
+	for _, contact := range cl.ContactsMatching(selector) {
+		data := contact.Data()
+		record := &ricochet.ContactRecord{
+			Address:  data.Address,
+			Nickname: data.Nickname,
+			Tags:     data.Tags,
+			Notes:    data.Notes,
+		}
+		if data.Request != nil {
+			record.Message = data.Request.Text
+		}
+		re = append(re, record)
+	}
+	sort.Slice(re, func(i, j int) bool { return re[i].Address < re[j].Address })
+	return re
+}
+
+// FormatContactRecords encodes records in a file format.
+func FormatContactRecords(records []*ricochet.ContactRecord, format ricochet.ContactRecordFormat) ([]byte, error) {
+	switch format {
+	case ricochet.ContactRecordFormat_JSON:
+		if records == nil {
+			records = []*ricochet.ContactRecord{}
+		}
+		return json.MarshalIndent(records, "", "  ")
+	case ricochet.ContactRecordFormat_CSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		w.Write(contactRecordColumns)
+		for _, record := range records {
+			w.Write([]string{
+				record.Address,
+				record.Nickname,
+				strings.Join(record.Tags, " "),
+				record.Notes,
+				record.Message,
+			})
+		}
+		w.Flush()
+		return buf.Bytes(), w.Error()
+	}
+	return nil, errors.New("Unknown contact record format")
+}
+
+// ParseContactRecords decodes records from a file format. Records aren't
+// validated, but there can't be more than maxImportRecords of them.
+func ParseContactRecords(data []byte, format ricochet.ContactRecordFormat) ([]*ricochet.ContactRecord, error) {
+	var re []*ricochet.ContactRecord
+	switch format {
+	case ricochet.ContactRecordFormat_JSON:
+		if err := json.Unmarshal(data, &re); err != nil {
+			return nil, fmt.Errorf("Invalid JSON contact records: %v", err)
+		}
+	case ricochet.ContactRecordFormat_CSV:
+		r := csv.NewReader(bytes.NewReader(data))
+		r.FieldsPerRecord = -1
+		header, err := r.Read()
+		if err != nil {
+			return nil, fmt.Errorf("Invalid CSV contact records: %v", err)
+		}
+		columns := make(map[string]int, len(header))
+		for i, name := range header {
+			columns[strings.ToLower(strings.TrimSpace(name))] = i
+		}
+		if _, ok := columns["address"]; !ok {
+			return nil, errors.New("CSV contact records have no address column")
+		}
+		for {
+			row, err := r.Read()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, fmt.Errorf("Invalid CSV contact records: %v", err)
+			}
+			field := func(name string) string {
+				if i, ok := columns[name]; ok && i < len(row) {
+					return strings.TrimSpace(row[i])
+				}
+				return ""
+			}
+			re = append(re, &ricochet.ContactRecord{
+				Address:  field("address"),
+				Nickname: field("nickname"),
+				Tags:     strings.Fields(field("tags")),
+				Notes:    field("notes"),
+				Message:  field("message"),
+			})
+			if len(re) > maxImportRecords {
+				break
+			}
+		}
+	default:
+		return nil, errors.New("Unknown contact record format")
+	}
+
+	if len(re) > maxImportRecords {
+		return nil, fmt.Errorf("Cannot import more than %d contacts at once", maxImportRecords)
+	}
+	return re, nil
+}
+
+// ImportContacts adds a contact request for each record, with fromNickname
+// sent in every request. Records for existing contacts are skipped. After a
+// request is added, it waits for interval, or defaultImportInterval if that's
+// zero, before adding the next, so that many connections aren't started at
+// once.
+//
+// The result of each record is passed to report as soon as it's handled. The
+// import stops if report returns an error or ctx is cancelled.
+func (cl *ContactList) ImportContacts(ctx context.Context, records []*ricochet.ContactRecord, fromNickname string, interval time.Duration, report func(*ricochet.ImportContactResult) error) error {
+	fromNickname = NormalizeNickname(fromNickname)
+	if len(fromNickname) > 0 && !IsNicknameAcceptable(fromNickname) {
+		return errors.New("Invalid 'from' nickname")
+	}
+	if interval <= 0 {
+		interval = defaultImportInterval
+	}
+
+	var lastRequest time.Time
+	for i, record := range records {
+		result := &ricochet.ImportContactResult{
+			Record:   int32(i + 1),
+			Address:  strings.TrimSpace(record.Address),
+			Nickname: record.Nickname,
+		}
+
+		if err := cl.importContact(ctx, record, result, fromNickname, lastRequest.Add(interval)); err != nil {
+			result.Result = ricochet.ImportContactResult_FAILED
+			result.Error = err.Error()
+		}
+		if result.Result == ricochet.ImportContactResult_ADDED {
+			lastRequest = time.Now()
+		}
+		if err := report(result); err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+
+	log.Printf("Imported %d contact records", len(records))
+	return nil
+}
+
+// importContact adds a contact request for one record, waiting until
+// notBefore, and fills in result.
+func (cl *ContactList) importContact(ctx context.Context, record *ricochet.ContactRecord, result *ricochet.ImportContactResult, fromNickname string, notBefore time.Time) error {
+	address := result.Address
+	if !IsAddressValid(address) {
+		return errors.New("Invalid ricochet address")
+	}
+	if cl.ContactByAddress(address) != nil {
+		result.Result = ricochet.ImportContactResult_SKIPPED
+		return nil
+	}
+	nickname := record.Nickname
+	if nickname == "" {
+		nickname, _ = PlainHostFromAddress(address)
+	}
+	tags, err := normalizeTags(record.Tags)
+	if err != nil {
+		return err
+	}
+	if err := validateNotes(record.Notes); err != nil {
+		return err
+	}
+
+	select {
+	case <-time.After(time.Until(notBefore)):
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	data, err := newContactRequestData(address, nickname, fromNickname, record.Message)
+	if err != nil {
+		return err
+	}
+	data.Tags = tags
+	data.Notes = record.Notes
+	contact, err := cl.addContactRequest(data)
+	if err != nil {
+		return err
+	}
+	result.Result = ricochet.ImportContactResult_ADDED
+	result.Nickname = contact.Nickname()
+	return nil
+}
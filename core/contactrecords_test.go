@@ -0,0 +1,62 @@
+package core
+
+import (
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"golang.org/x/net/context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestImportContactTagsAndNotes(t *testing.T) {
+	core := newTestCore(t)
+	cl := core.Identity.ContactList()
+	events := cl.EventMonitor().Subscribe(10)
+	defer cl.EventMonitor().Unsubscribe(events)
+
+	records := []*ricochet.ContactRecord{
+		&ricochet.ContactRecord{
+			Address:  testContactAddress,
+			Nickname: "Alice",
+			Tags:     []string{"work", "friends"},
+			Notes:    "Met at the conference",
+		},
+	}
+	var results []*ricochet.ImportContactResult
+	err := cl.ImportContacts(context.Background(), records, "", time.Millisecond, func(result *ricochet.ImportContactResult) error {
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Importing contacts failed: %v", err)
+	}
+	if len(results) != 1 || results[0].Result != ricochet.ImportContactResult_ADDED || results[0].Error != "" {
+		t.Fatalf("Import results are %v, expected the contact to be added", results)
+	}
+
+	data := core.Config.Read().Contacts[testContactAddress]
+	if data == nil || data.Request == nil || data.Notes != records[0].Notes {
+		t.Errorf("Imported contact was not saved with its request and notes: %v", data)
+	} else if tags, _ := normalizeTags(records[0].Tags); !reflect.DeepEqual(data.Tags, tags) {
+		t.Errorf("Imported contact was saved with tags %v, expected %v", data.Tags, tags)
+	}
+
+	// The contact is added along with its tags and notes, rather than updated
+	// after it was added
+	select {
+	case value := <-events:
+		event, ok := value.(ricochet.ContactEvent)
+		if !ok || event.Type != ricochet.ContactEvent_ADD {
+			t.Fatalf("First event is %v, expected ADD", value)
+		}
+		if contact := event.GetContact(); contact == nil || len(contact.Tags) == 0 || contact.Notes == "" {
+			t.Errorf("Contact was added without tags and notes: %v", contact)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("No event for the imported contact")
+	}
+
+	if contact := cl.ContactByAddress(testContactAddress); contact != nil {
+		cl.RemoveContact(contact)
+	}
+}
@@ -11,8 +11,6 @@ import (
 	"time"
 )
 
-// XXX should have limits on backlog size/duration
-
 type Conversation struct {
 	Contact *Contact
 
@@ -22,6 +20,7 @@ type Conversation struct {
 	remoteEntity      *ricochet.Entity
 	messages          []*ricochet.Message
 	lastSentMessageId uint32
+	closed            bool
 
 	events *utils.Publisher
 }
@@ -61,18 +60,35 @@ func (c *Conversation) Receive(id uint64, timestamp int64, text string) {
 	}
 
 	c.mutex.Lock()
-	defer c.mutex.Unlock()
+	if c.closed {
+		c.mutex.Unlock()
+		return
+	}
+
+	if introduction := IntroductionFromMessage(text); introduction != nil {
+		introduction.IntroducedBy = c.remoteEntity.Address
+		introduction.WhenReceived = time.Now().Format(time.RFC3339)
+		message.Introduction = introduction
+	}
 
 	// XXX The Qt implementation would discard duplicate messages by checking
 	// the most recent 5 messages for any received message that matches this one
 	// in both id and text. Should do that here.
 
 	c.messages = append(c.messages, message)
+	c.trimBacklog()
 	event := ricochet.ConversationEvent{
 		Type: ricochet.ConversationEvent_RECEIVE,
 		Msg:  message,
 	}
 	c.events.Publish(event)
+	c.mutex.Unlock()
+
+	// Keeping an introduction saves the config, which isn't done while holding
+	// the mutex
+	if message.Introduction != nil {
+		c.Contact.core.Identity.ContactList().receivedIntroduction(message.Introduction)
+	}
 }
 
 func (c *Conversation) UpdateSentStatus(id uint64, success bool) {
@@ -103,6 +119,13 @@ func (c *Conversation) UpdateSentStatus(id uint64, success bool) {
 }
 
 func (c *Conversation) Send(text string) (*ricochet.Message, error) {
+	return c.send(text, nil)
+}
+
+// send sends a message, which is marked as introducing a contact if
+// introduction is set. Introductions are only marked on messages that were
+// built by MessageWithIntroduction, not on text that looks like one.
+func (c *Conversation) send(text string, introduction *ricochet.Introduction) (*ricochet.Message, error) {
 	if len(text) == 0 {
 		return nil, errors.New("Message text is empty")
 	} else if len(text) > 2000 {
@@ -111,6 +134,9 @@ func (c *Conversation) Send(text string) (*ricochet.Message, error) {
 
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
+	if c.closed {
+		return nil, errors.New("Conversation is closed")
+	}
 
 	if c.lastSentMessageId == 0 {
 		// Rand is seeded by Ricochet.Init
@@ -128,6 +154,7 @@ func (c *Conversation) Send(text string) (*ricochet.Message, error) {
 		Status:     ricochet.Message_QUEUED,
 		Text:       text,
 	}
+	message.Introduction = introduction
 
 	if online, err := c.sendMessageToConnection(message); err != nil {
 		if online {
@@ -140,6 +167,7 @@ func (c *Conversation) Send(text string) (*ricochet.Message, error) {
 	}
 
 	c.messages = append(c.messages, message)
+	c.trimBacklog()
 	event := ricochet.ConversationEvent{
 		Type: ricochet.ConversationEvent_SEND,
 		Msg:  message,
@@ -149,6 +177,39 @@ func (c *Conversation) Send(text string) (*ricochet.Message, error) {
 	return message, nil
 }
 
+// trimBacklog discards the oldest messages when the conversation is longer than
+// the configured backlog. Queued messages are kept until they're sent. Assumes
+// the mutex is held.
+func (c *Conversation) trimBacklog() {
+	limit := settingsConversationBacklog(c.Contact.core.Settings())
+	excess := len(c.messages) - limit
+	if excess <= 0 {
+		return
+	}
+
+	kept := c.messages[:0]
+	for _, message := range c.messages {
+		if excess > 0 && message.Status != ricochet.Message_QUEUED {
+			excess--
+			continue
+		}
+		kept = append(kept, message)
+	}
+	for i := len(kept); i < len(c.messages); i++ {
+		c.messages[i] = nil
+	}
+	c.messages = kept
+}
+
+// close discards the conversation when its contact is destroyed. Messages
+// can't be sent or received afterwards.
+func (c *Conversation) close() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.closed = true
+	c.messages = nil
+}
+
 // Send all messages in the QUEUED state to the contact, if
 // a connection is available. Should be called after a new
 // connection is established.
@@ -219,7 +280,7 @@ func (c *Conversation) MarkReadBeforeMessage(msgId uint64) int {
 // Implement ChatChannelHandler (im.ricochet.chat)
 func (c *Conversation) ChatMessage(messageID uint32, when time.Time, message string) bool {
 	// XXX sanity checks, message contents, etc
-	log.Printf("chat message: %d %d %v %s", messageID, when, message)
+	log.Printf("chat message: %d %v %s", messageID, when, message)
 
 	c.Receive(uint64(messageID), when.Unix(), message)
 	return true
@@ -0,0 +1,81 @@
+package core
+
+import (
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"time"
+)
+
+// Number of connections kept in the history of each contact
+const maxConnectionRecords = 200
+
+// recordConnectionState updates the connection history of address in a
+// locked config when a contact connects, disconnects, or replaces its
+// connection. Any open record is closed, and a new one is opened if the
+// contact is connected.
+func recordConnectionState(config *ricochet.Config, address string, connected, inbound bool, now time.Time) {
+	if config.ContactHistory == nil {
+		config.ContactHistory = make(map[string]*ricochet.ContactHistory)
+	}
+	history := config.ContactHistory[address]
+	if history == nil {
+		history = &ricochet.ContactHistory{Address: address}
+		config.ContactHistory[address] = history
+	}
+
+	if n := len(history.Connections); n > 0 {
+		record := history.Connections[n-1]
+		if isOpenRecord(record) {
+			record.WhenDisconnected = now.Format(time.RFC3339)
+			if start, err := time.Parse(time.RFC3339, record.WhenConnected); err == nil {
+				record.Duration = int64(now.Sub(start) / time.Second)
+			}
+		}
+	}
+
+	if connected {
+		history.Connections = append(history.Connections, &ricochet.ConnectionRecord{
+			WhenConnected: now.Format(time.RFC3339),
+			Inbound:       inbound,
+		})
+		if excess := len(history.Connections) - maxConnectionRecords; excess > 0 {
+			history.Connections = history.Connections[excess:]
+		}
+	}
+}
+
+// markInterruptedConnections flags connections that were still open when the
+// backend last stopped, because they can't be closed with a correct time.
+func (cl *ContactList) markInterruptedConnections() {
+	open := false
+	for _, history := range cl.core.Config.Read().ContactHistory {
+		if n := len(history.Connections); n > 0 && isOpenRecord(history.Connections[n-1]) {
+			open = true
+			break
+		}
+	}
+	if !open {
+		return
+	}
+
+	config := cl.core.Config.Lock()
+	defer cl.core.Config.Unlock()
+	for _, history := range config.ContactHistory {
+		if n := len(history.Connections); n > 0 && isOpenRecord(history.Connections[n-1]) {
+			history.Connections[n-1].Interrupted = true
+		}
+	}
+}
+
+// History returns a **read-only** snapshot of the recent connections to a
+// contact, oldest first.
+func (c *Contact) History() *ricochet.ContactHistory {
+	address := c.Address()
+	if history := c.core.Config.Read().ContactHistory[address]; history != nil {
+		return history
+	}
+	return &ricochet.ContactHistory{Address: address}
+}
+
+func isOpenRecord(record *ricochet.ConnectionRecord) bool {
+	return record.WhenDisconnected == "" && !record.Interrupted
+}
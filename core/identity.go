@@ -53,6 +53,10 @@ func CreateIdentity(core *Ricochet) (*Identity, error) {
 func (me *Identity) loadIdentity() error {
 	config := me.core.Config.Read()
 
+	if me.IsEphemeral() {
+		log.Printf("Using ephemeral identity; nothing will be saved")
+	}
+
 	if keyData := config.Secrets.GetServicePrivateKey(); keyData != nil {
 		var err error
 		me.privateKey, _, err = pkcs1.DecodePrivateKeyDER(keyData)
@@ -72,6 +76,13 @@ func (me *Identity) loadIdentity() error {
 	return nil
 }
 
+// IsEphemeral returns true if the identity is only kept in memory. A new
+// onion key is generated for each ephemeral identity, and it is forgotten
+// along with all contacts when the process exits.
+func (me *Identity) IsEphemeral() bool {
+	return me.core.Config.IsEphemeral()
+}
+
 func (me *Identity) setPrivateKey(key *rsa.PrivateKey) error {
 	me.mutex.Lock()
 	defer me.mutex.Unlock()
@@ -90,7 +101,10 @@ func (me *Identity) setPrivateKey(key *rsa.PrivateKey) error {
 		config.Secrets = &ricochet.Secrets{}
 	}
 	config.Secrets.ServicePrivateKey = keyData
-	me.core.Config.Unlock()
+	if err := me.core.Config.Unlock(); err != nil {
+		// Without a saved key, this identity would be lost at exit
+		return err
+	}
 
 	// Update Identity
 	me.address, err = AddressFromKey(&key.PublicKey)
@@ -166,6 +180,11 @@ func (me *Identity) handleInboundConnection(conn net.Conn) error {
 		if err != nil {
 			return false, false
 		}
+		if address, _ := AddressFromPlainHost(hostname); me.contactList.IsBlocked(address) {
+			// Refused like any other unauthorized peer
+			log.Printf("Refused inbound connection from blocked address %s", address)
+			return false, false
+		}
 		// allowed, known
 		return true, contact != nil
 	}
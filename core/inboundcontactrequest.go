@@ -2,6 +2,7 @@ package core
 
 import (
 	"errors"
+	"fmt"
 	"github.com/ricochet-im/ricochet-go/rpc"
 	channels "github.com/s-rah/go-ricochet/channels"
 	connection "github.com/s-rah/go-ricochet/connection"
@@ -36,6 +37,7 @@ func (irh *inboundRequestHandler) ContactRequestRejected() {}
 func (irh *inboundRequestHandler) ContactRequestAccepted() {}
 func (irh *inboundRequestHandler) ContactRequestError()    {}
 func (irh *inboundRequestHandler) ContactRequest(name, message string) string {
+	name = NormalizeNickname(name)
 	if len(name) > 0 && !IsNicknameAcceptable(name) {
 		log.Printf("protocol: Stripping unacceptable nickname from inbound request; encoded: %x", []byte(name))
 		name = ""
@@ -89,7 +91,15 @@ func HandleInboundRequestConnection(conn *connection.Connection, contactList *Co
 		return <-processChan
 	}
 
-	// Expecting to receive request data within 15 seconds
+	limiter := contactList.requestLimiter
+	if err := limiter.startConnection(address); err != nil {
+		conn.Conn.Close()
+		<-processChan
+		return err
+	}
+	defer limiter.endConnection()
+
+	// Expecting to receive request data within the configured timeout
 	select {
 	case <-req.RequestReceivedChan:
 		break
@@ -99,7 +109,7 @@ func HandleInboundRequestConnection(conn *connection.Connection, contactList *Co
 			err = errors.New("unexpected break")
 		}
 		return err
-	case <-time.After(15 * time.Second):
+	case <-time.After(settingsRequestTimeout(contactList.core.Settings())):
 		// Didn't receive a contact request fast enough
 		conn.Conn.Close()
 		return <-processChan
@@ -108,6 +118,14 @@ func HandleInboundRequestConnection(conn *connection.Connection, contactList *Co
 	// Function to respond to the request; changed after the initial response
 	respond := func(status string) { req.ResponseChan <- status }
 
+	// Reply to requests during the cooldown, so that the peer doesn't retry
+	// immediately, but don't extend the cooldown
+	if limiter.inCooldown(address) {
+		respond("Rejected")
+		conn.Conn.Close()
+		return <-processChan
+	}
+
 	request, contact := contactList.AddOrUpdateInboundContactRequest(address, req.Name, req.Message)
 	if contact == nil && request != nil && !request.IsRejected() {
 		// Pending request; keep connection open and wait for a user response
@@ -160,11 +178,9 @@ func HandleInboundRequestConnection(conn *connection.Connection, contactList *Co
 		contact.AssignConnection(conn)
 		return nil
 	} else {
-		// Rejected
+		// Rejected; the record of a rejected request is kept until it expires
 		respond("Rejected")
-		if request != nil {
-			contactList.RemoveInboundContactRequest(request)
-		}
+		limiter.rejected(address)
 		conn.Conn.Close()
 		return <-processChan
 	}
@@ -190,6 +206,24 @@ func CreateInboundContactRequest(core *Ricochet, address, nickname, message stri
 	return cr
 }
 
+// inboundContactRequestFromConfig constructs an InboundContactRequest that was saved
+// in the config by ContactList.
+func inboundContactRequestFromConfig(core *Ricochet, data *ricochet.ContactRequest) (*InboundContactRequest, error) {
+	if data.Direction != ricochet.ContactRequest_INBOUND {
+		return nil, fmt.Errorf("Inbound contact request for %s has the wrong direction", data.Address)
+	}
+	if !IsAddressValid(data.Address) {
+		return nil, fmt.Errorf("Invalid inbound contact request address '%s'", data.Address)
+	}
+
+	cr := &InboundContactRequest{
+		core:    core,
+		data:    *data,
+		Address: data.Address,
+	}
+	return cr, nil
+}
+
 // getContactResultChannel returns a channel that will be sent a Contact if the request is
 // accepted, nil if the request is rejected, or closed if the channel is no longer used.
 // This is used to communciate with active connections for pending requests.
@@ -243,10 +277,19 @@ func (cr *InboundContactRequest) Update(nickname, message string) {
 	}
 }
 
+// setSimilarContact records the address of a contact whose nickname looks
+// like the nickname of this request, without signalling a change.
+func (cr *InboundContactRequest) setSimilarContact(address string) {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+	cr.data.SimilarContact = address
+}
+
 func (cr *InboundContactRequest) SetNickname(nickname string) error {
 	cr.mutex.Lock()
 	defer cr.mutex.Unlock()
 
+	nickname = NormalizeNickname(nickname)
 	if IsNicknameAcceptable(nickname) {
 		cr.data.FromNickname = nickname
 	} else {
@@ -255,11 +298,13 @@ func (cr *InboundContactRequest) SetNickname(nickname string) error {
 	return nil
 }
 
+// Accept adds a contact for the request, and removes the request. The mutex
+// isn't held while calling into ContactList, which locks its own mutex before
+// the mutex of a request.
 func (cr *InboundContactRequest) Accept() (*Contact, error) {
 	cr.mutex.Lock()
-	defer cr.mutex.Unlock()
-
 	if cr.data.Rejected {
+		cr.mutex.Unlock()
 		log.Printf("Accept called on an inbound contact request that was already rejected; request is %v", cr)
 		return nil, errors.New("Contact request has already been rejected")
 	}
@@ -271,27 +316,26 @@ func (cr *InboundContactRequest) Accept() (*Contact, error) {
 		Nickname:    cr.data.FromNickname,
 		WhenCreated: cr.data.WhenCreated,
 	}
-	contact, err := cr.core.Identity.ContactList().AddNewContact(data)
+	cr.mutex.Unlock()
+
+	contact, err := cr.core.Identity.ContactList().addAcceptedContact(data)
 	if err != nil {
 		log.Printf("Error occurred in accepting contact request: %s", err)
 		return nil, err
 	}
 
-	if err := cr.acceptWithContact(contact); err != nil {
+	if err := cr.AcceptWithContact(contact); err != nil {
 		return contact, err
 	}
 	return contact, nil
 }
 
+// AcceptWithContact signals an active connection that the request was
+// accepted as contact, and removes the request from ContactList.
 func (cr *InboundContactRequest) AcceptWithContact(contact *Contact) error {
 	cr.mutex.Lock()
-	defer cr.mutex.Unlock()
-	return cr.acceptWithContact(contact)
-}
-
-// Assumes mutex
-func (cr *InboundContactRequest) acceptWithContact(contact *Contact) error {
 	if contact.Address() != cr.data.Address {
+		cr.mutex.Unlock()
 		return errors.New("Contact address does not match request in accept")
 	}
 
@@ -301,6 +345,7 @@ func (cr *InboundContactRequest) acceptWithContact(contact *Contact) error {
 		close(cr.contactResultChan)
 		cr.contactResultChan = nil
 	}
+	cr.mutex.Unlock()
 
 	cr.core.Identity.ContactList().RemoveInboundContactRequest(cr)
 	return nil
@@ -316,6 +361,7 @@ func (cr *InboundContactRequest) Reject() {
 
 	log.Printf("Rejecting contact request from %s", cr.data.Address)
 	cr.data.Rejected = true
+	cr.data.WhenRejected = time.Now().Format(time.RFC3339)
 
 	// Signal to the active connection
 	if cr.contactResultChan != nil {
@@ -324,18 +370,36 @@ func (cr *InboundContactRequest) Reject() {
 		cr.contactResultChan = nil
 	}
 
-	// Signal update to the callback (probably from ContactList)
+	// Signal update to the callback (probably from ContactList), which keeps
+	// the rejected request to refuse future requests until it expires
 	if cr.StatusChanged != nil {
 		cr.StatusChanged(cr)
 	}
-
-	cr.core.Identity.ContactList().RemoveInboundContactRequest(cr)
 }
 
 func (cr *InboundContactRequest) Data() ricochet.ContactRequest {
 	return cr.data
 }
 
+// isExpired returns true if the request should be forgotten because it has
+// been pending or rejected for longer than expiry. ContactList calls this while
+// holding its mutex, which is always locked before the mutex of a request.
+func (cr *InboundContactRequest) isExpired(expiry time.Duration) bool {
+	cr.mutex.Lock()
+	defer cr.mutex.Unlock()
+
+	when := cr.data.WhenCreated
+	if cr.data.Rejected && cr.data.WhenRejected != "" {
+		when = cr.data.WhenRejected
+	}
+	t, err := time.Parse(time.RFC3339, when)
+	if err != nil {
+		// Requests with no valid timestamp are expired
+		return true
+	}
+	return time.Since(t) > expiry
+}
+
 func (cr *InboundContactRequest) IsRejected() bool {
 	cr.mutex.Lock()
 	defer cr.mutex.Unlock()
@@ -0,0 +1,200 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"log"
+	"regexp"
+	"time"
+)
+
+// Introductions are included in a chat message as a line in this form, e.g.
+// "introduce:ricochet:abcdefghijklmnop?nickname=Alice". The "introduce-mutual:"
+// form means the other contact was also introduced to the recipient.
+//
+// An introduction doesn't authorize anything by itself, and a contact request
+// to the introduced address is answered like any other. Only the introduced
+// contact can allow the request to be accepted automatically: they introduce
+// themselves to us with a single-use invite, and we include that invite in the
+// next introduction of them that we send.
+var introductionPattern = regexp.MustCompile(`(?m)^introduce(-mutual)?:(ricochet:\S+)$`)
+
+const (
+	// Invites in introductions of ourselves expire after this long, and
+	// invites from contacts are kept for this long
+	introductionExpiry = 30 * 24 * time.Hour
+	// Most invites kept from each contact, and in total
+	maxIntroductionsPerContact = 5
+	maxIntroductions           = 100
+)
+
+// IntroductionFromMessage returns the introduction included in a chat message,
+// or nil. Only the address, nickname, mutual, and invite fields are set.
+func IntroductionFromMessage(text string) *ricochet.Introduction {
+	match := introductionPattern.FindStringSubmatch(text)
+	if match == nil {
+		return nil
+	}
+	uri, err := ParseContactURI(match[2])
+	if err != nil {
+		return nil
+	}
+	return &ricochet.Introduction{
+		Address:  uri.Address,
+		Nickname: uri.Nickname,
+		Mutual:   match[1] != "",
+		Invite:   uri.Invite,
+	}
+}
+
+// MessageWithIntroduction adds an introduction to a chat message.
+func MessageWithIntroduction(text string, introduction *ricochet.Introduction) string {
+	line := "introduce:"
+	if introduction.Mutual {
+		line = "introduce-mutual:"
+	}
+	line += FormatContactURI(&ricochet.ContactURI{
+		Address:  introduction.Address,
+		Nickname: introduction.Nickname,
+		Invite:   introduction.Invite,
+	})
+	if text == "" {
+		return line
+	}
+	return text + "\n\n" + line
+}
+
+func isIntroductionExpired(introduction *ricochet.Introduction) bool {
+	received, err := time.Parse(time.RFC3339, introduction.WhenReceived)
+	return err != nil || time.Since(received) > introductionExpiry
+}
+
+// IntroduceContact sends a message to a contact that introduces another
+// contact, using our nickname for them. If mutual is set, the other contact
+// is also sent an introduction back. An invite that the introduced contact
+// gave us to pass on is included, if there is one.
+//
+// If introduced is nil, we introduce ourselves instead. A single-use invite
+// is created and included, which the contact can pass on in an introduction
+// of us.
+func (cl *ContactList) IntroduceContact(to, introduced *Contact, mutual bool) error {
+	if to == introduced {
+		return errors.New("Cannot introduce a contact to themselves")
+	}
+	for _, contact := range []*Contact{to, introduced} {
+		if contact == nil {
+			continue
+		}
+		data := contact.Data()
+		if data.Request != nil || data.Status == ricochet.Contact_REJECTED {
+			return fmt.Errorf("Contact %s must be accepted to be introduced", data.Nickname)
+		}
+	}
+
+	if introduced == nil {
+		if mutual {
+			return errors.New("Introductions of ourselves cannot be mutual")
+		}
+		return cl.introduceSelf(to)
+	}
+
+	if err := cl.sendIntroduction(to, introduced, mutual); err != nil {
+		return err
+	}
+	if mutual {
+		if err := cl.sendIntroduction(introduced, to, mutual); err != nil {
+			return err
+		}
+	}
+	log.Printf("Introduced %s to %s", introduced.Address(), to.Address())
+	return nil
+}
+
+func (cl *ContactList) sendIntroduction(to, introduced *Contact, mutual bool) error {
+	nickname := introduced.Nickname()
+	introduction := &ricochet.Introduction{
+		Address:  introduced.Address(),
+		Nickname: nickname,
+		Mutual:   mutual,
+		Invite:   cl.takeIntroductionInvite(introduced.Address()),
+	}
+	text := MessageWithIntroduction(fmt.Sprintf("I'd like to introduce you to %s.", nickname), introduction)
+	_, err := to.Conversation().send(text, introduction)
+	return err
+}
+
+// introduceSelf sends a contact an introduction of ourselves with a new
+// single-use invite. The invite is revoked if the message can't be sent.
+func (cl *ContactList) introduceSelf(to *Contact) error {
+	invite, err := cl.CreateInvite("", 1, introductionExpiry)
+	if err != nil {
+		return err
+	}
+	introduction := &ricochet.Introduction{
+		Address: cl.core.Identity.Address(),
+		Invite:  invite.Token,
+	}
+	text := MessageWithIntroduction("You can introduce me to your contacts.", introduction)
+	if _, err := to.Conversation().send(text, introduction); err != nil {
+		cl.RevokeInvite(invite.Token)
+		return err
+	}
+	log.Printf("Introduced ourselves to %s with invite %s", to.Address(), inviteID(invite.Token))
+	return nil
+}
+
+// takeIntroductionInvite removes and returns an invite that address gave us
+// to pass on in an introduction of them, or an empty string.
+func (cl *ContactList) takeIntroductionInvite(address string) string {
+	config := cl.core.Config.Lock()
+	defer cl.core.Config.Unlock()
+	for token, introduction := range config.Introductions {
+		if introduction.IntroducedBy == address && !isIntroductionExpired(introduction) {
+			delete(config.Introductions, token)
+			return token
+		}
+	}
+	return ""
+}
+
+// receivedIntroduction keeps the invite from a contact's introduction of
+// themselves, to pass on in our next introduction of them. Introductions of
+// anyone else are not kept. Expired invites are removed, and a contact's
+// oldest invite is replaced after maxIntroductionsPerContact; new invites are
+// ignored once there are maxIntroductions.
+func (cl *ContactList) receivedIntroduction(introduction *ricochet.Introduction) {
+	if introduction.Invite == "" || introduction.Address != introduction.IntroducedBy {
+		return
+	}
+
+	config := cl.core.Config.Lock()
+	defer cl.core.Config.Unlock()
+	if config.Introductions[introduction.Invite] != nil {
+		return
+	}
+	oldest, count := "", 0
+	for token, other := range config.Introductions {
+		if isIntroductionExpired(other) {
+			delete(config.Introductions, token)
+		} else if other.IntroducedBy == introduction.IntroducedBy {
+			count++
+			if oldest == "" || other.WhenReceived < config.Introductions[oldest].WhenReceived {
+				oldest = token
+			}
+		}
+	}
+	if count >= maxIntroductionsPerContact {
+		delete(config.Introductions, oldest)
+	} else if len(config.Introductions) >= maxIntroductions {
+		log.Printf("Ignoring invite from %s because too many invites are kept", introduction.IntroducedBy)
+		return
+	}
+
+	if config.Introductions == nil {
+		config.Introductions = make(map[string]*ricochet.Introduction)
+	}
+	config.Introductions[introduction.Invite] = proto.Clone(introduction).(*ricochet.Introduction)
+	log.Printf("Received invite %s from %s to pass on in introductions", inviteID(introduction.Invite), introduction.IntroducedBy)
+}
@@ -0,0 +1,209 @@
+package core
+
+import (
+	cryptorand "crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/hex"
+	"errors"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Invite tokens are included in the message of a contact request in this
+// form, e.g. "invite:abcdefghijklmnopqrstuvwxyz"
+var inviteTokenPattern = regexp.MustCompile(`\binvite:([a-z2-7]{26})\b`)
+
+// InviteTokenFromMessage returns the invite token included in a contact
+// request message, or an empty string.
+func InviteTokenFromMessage(message string) string {
+	if match := inviteTokenPattern.FindStringSubmatch(message); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// MessageWithInviteToken adds an invite token to a contact request message.
+func MessageWithInviteToken(message, token string) string {
+	if message == "" {
+		return "invite:" + token
+	}
+	return message + "\n\ninvite:" + token
+}
+
+// inviteID returns a short identifier for an invite, which can be shown or
+// logged without revealing its token.
+func inviteID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:4])
+}
+
+func isInviteTokenValid(token string) bool {
+	return len(token) == 26 && isBase32Valid(token)
+}
+
+func generateInviteToken() (string, error) {
+	data := make([]byte, 16)
+	if _, err := cryptorand.Read(data); err != nil {
+		return "", err
+	}
+	token := base32.StdEncoding.EncodeToString(data)
+	return strings.ToLower(strings.TrimRight(token, "=")), nil
+}
+
+// CreateInvite creates an invite that allows contact requests carrying its
+// token to be accepted automatically. The contact is given nickname, or the
+// nickname suggested by the request if that's empty. Invites can be used
+// maxUses times, or without limit if that's zero, and expire after validFor
+// unless that's zero.
+func (cl *ContactList) CreateInvite(nickname string, maxUses uint32, validFor time.Duration) (*ricochet.Invite, error) {
+	nickname = NormalizeNickname(nickname)
+	if len(nickname) > 0 && !IsNicknameAcceptable(nickname) {
+		return nil, errors.New("Invalid nickname")
+	}
+	address := cl.core.Identity.Address()
+	if address == "" {
+		return nil, errors.New("Identity is not ready yet")
+	}
+
+	token, err := generateInviteToken()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	invite := &ricochet.Invite{
+		Token:       token,
+		Nickname:    nickname,
+		MaxUses:     maxUses,
+		WhenCreated: now.Format(time.RFC3339),
+		Link:        FormatContactURI(&ricochet.ContactURI{Address: address, Invite: token}),
+	}
+	if validFor > 0 {
+		invite.WhenExpires = now.Add(validFor).Format(time.RFC3339)
+	}
+
+	config := cl.core.Config.Lock()
+	if config.Invites == nil {
+		config.Invites = make(map[string]*ricochet.Invite)
+	}
+	config.Invites[token] = invite
+	if err := cl.core.Config.Unlock(); err != nil {
+		// Don't keep an invite that the user never received
+		config := cl.core.Config.Lock()
+		delete(config.Invites, token)
+		cl.core.Config.Unlock()
+		return nil, err
+	}
+
+	log.Printf("Created invite %s", inviteID(token))
+	return invite, nil
+}
+
+// Invites returns all invites that are still valid.
+func (cl *ContactList) Invites() []*ricochet.Invite {
+	config := cl.core.Config.Read()
+	re := make([]*ricochet.Invite, 0, len(config.Invites))
+	for _, invite := range config.Invites {
+		if !isInviteExpired(invite) {
+			re = append(re, invite)
+		}
+	}
+	return re
+}
+
+// RevokeInvite removes an invite, so that it can no longer be used.
+func (cl *ContactList) RevokeInvite(token string) error {
+	cl.mutex.Lock()
+	defer cl.mutex.Unlock()
+
+	config := cl.core.Config.Lock()
+	if config.Invites[token] == nil {
+		cl.core.Config.Unlock()
+		return errors.New("Invite does not exist")
+	}
+	delete(config.Invites, token)
+	if err := cl.core.Config.Unlock(); err != nil {
+		return err
+	}
+
+	log.Printf("Revoked invite %s", inviteID(token))
+	return nil
+}
+
+func isInviteExpired(invite *ricochet.Invite) bool {
+	if invite.WhenExpires == "" {
+		return false
+	}
+	expires, err := time.Parse(time.RFC3339, invite.WhenExpires)
+	return err != nil || time.Now().After(expires)
+}
+
+// acceptInvite checks an inbound contact request message for a valid invite
+// token, and if there is one, uses the invite to add the contact. If the
+// invite can't be used, for example because the nickname is invalid, it
+// returns nil and the request should be handled normally. A use of the invite
+// is only counted once the contact is added. Assumes the mutex is held.
+func (cl *ContactList) acceptInvite(address, nickname, message string) *Contact {
+	token := InviteTokenFromMessage(message)
+	if token == "" {
+		return nil
+	}
+
+	invite := cl.core.Config.Read().Invites[token]
+	if invite == nil {
+		log.Printf("Contact request from %s has an unknown invite token", address)
+		return nil
+	}
+	if isInviteExpired(invite) {
+		config := cl.core.Config.Lock()
+		delete(config.Invites, token)
+		cl.core.Config.Unlock()
+		log.Printf("Contact request from %s has an expired invite", address)
+		return nil
+	}
+	if invite.Nickname != "" {
+		nickname = invite.Nickname
+	}
+	if !IsNicknameAcceptable(nickname) {
+		log.Printf("Not accepting invite from %s automatically without a nickname", address)
+		return nil
+	}
+	if cl.IsBlocked(address) {
+		log.Printf("Not accepting invite from blocked address %s", address)
+		return nil
+	}
+
+	nickname = cl.availableNickname(nickname)
+	log.Printf("Accepting contact request from %s with invite %s", address, inviteID(token))
+	contact, err := cl.addNewContact(&ricochet.Contact{
+		Address:     address,
+		Nickname:    nickname,
+		WhenCreated: time.Now().Format(time.RFC3339),
+	})
+	if err != nil {
+		log.Printf("Accepting contact request with invite failed: %v", err)
+		return nil
+	}
+
+	// Count the use while the mutex is still held, so that the invite can't be
+	// used by another request or revoked in between
+	config := cl.core.Config.Lock()
+	if invite := config.Invites[token]; invite != nil {
+		invite.Uses++
+		if invite.MaxUses > 0 && invite.Uses >= invite.MaxUses {
+			delete(config.Invites, token)
+		}
+	}
+	if err := cl.core.Config.Unlock(); err != nil {
+		log.Printf("Saving use of invite %s failed: %v", inviteID(token), err)
+	}
+
+	// Remove any request that was pending for this address
+	if request := cl.inboundRequests[address]; request != nil {
+		cl.removeInboundRequest(request)
+	}
+	return contact
+}
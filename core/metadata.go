@@ -0,0 +1,83 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"strconv"
+	"unicode/utf8"
+)
+
+const (
+	MaxNotesLength         = 4000
+	MaxMetadataFields      = 32
+	MaxMetadataValueLength = 500
+)
+
+func validateNotes(notes string) error {
+	if len(notes) > MaxNotesLength {
+		return fmt.Errorf("Notes cannot be more than %d bytes", MaxNotesLength)
+	} else if !utf8.ValidString(notes) {
+		return errors.New("Invalid notes")
+	}
+	return nil
+}
+
+// validateMetadata checks the size of a metadata map. Keys follow the same
+// rules as tags, and every value must have a type.
+func validateMetadata(metadata map[string]*ricochet.MetadataValue) error {
+	if len(metadata) > MaxMetadataFields {
+		return fmt.Errorf("Contacts cannot have more than %d metadata fields", MaxMetadataFields)
+	}
+	for key, value := range metadata {
+		if !IsTagAcceptable(key) {
+			return fmt.Errorf("Invalid metadata field name '%s'", key)
+		}
+		if value == nil || value.Value == nil {
+			return fmt.Errorf("Metadata field '%s' has no value", key)
+		}
+		if text, ok := value.Value.(*ricochet.MetadataValue_Text); ok {
+			if len(text.Text) > MaxMetadataValueLength || !utf8.ValidString(text.Text) {
+				return fmt.Errorf("Invalid value for metadata field '%s'", key)
+			}
+		}
+	}
+	return nil
+}
+
+func copyMetadata(metadata map[string]*ricochet.MetadataValue) map[string]*ricochet.MetadataValue {
+	if metadata == nil {
+		return nil
+	}
+	re := make(map[string]*ricochet.MetadataValue, len(metadata))
+	for key, value := range metadata {
+		re[key] = proto.Clone(value).(*ricochet.MetadataValue)
+	}
+	return re
+}
+
+// ParseMetadataValue guesses the type of a metadata value given as text:
+// "true" and "false" are flags, integers are numbers, and anything else
+// is text.
+func ParseMetadataValue(value string) *ricochet.MetadataValue {
+	if value == "true" || value == "false" {
+		return &ricochet.MetadataValue{Value: &ricochet.MetadataValue_Flag{Flag: value == "true"}}
+	} else if number, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return &ricochet.MetadataValue{Value: &ricochet.MetadataValue_Number{Number: number}}
+	}
+	return &ricochet.MetadataValue{Value: &ricochet.MetadataValue_Text{Text: value}}
+}
+
+// FormatMetadataValue returns a metadata value as text.
+func FormatMetadataValue(value *ricochet.MetadataValue) string {
+	switch v := value.GetValue().(type) {
+	case *ricochet.MetadataValue_Text:
+		return v.Text
+	case *ricochet.MetadataValue_Number:
+		return strconv.FormatInt(v.Number, 10)
+	case *ricochet.MetadataValue_Flag:
+		return strconv.FormatBool(v.Flag)
+	}
+	return ""
+}
@@ -297,8 +297,6 @@ func (n *Network) WaitForProxyDialer(forward proxy.Dialer, c context.Context) (p
 			return nil, c.Err()
 		}
 	}
-
-	return nil, errors.New("No valid SOCKS configuration")
 }
 
 // Return the control connection, blocking until connected if necessary
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"fmt"
+	"golang.org/x/text/unicode/norm"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Nicknames are compared by a skeleton, which maps characters that are easily
+// confused to a single form, loosely following the skeleton of Unicode TR39.
+// Two nicknames with the same skeleton look alike, even if they are different
+// strings. This is used to keep contact nicknames distinct, and to warn about
+// contact requests that could be impersonating an existing contact.
+//
+// The skeleton is case sensitive, like TR39, so "alice" and "Alice" are still
+// different nicknames.
+
+// confusableRunes maps characters to the character they could be mistaken for.
+// Compatibility forms (fullwidth, mathematical letters, etc) and accents are
+// already removed by decomposing before this mapping.
+var confusableRunes = map[rune]rune{
+	// Digits and symbols
+	'0': 'O', '1': 'l', 'I': 'l', '|': 'l',
+	'ǀ': 'l', 'ı': 'i', 'ȷ': 'j', 'ɡ': 'g', 'ɑ': 'a', 'ʏ': 'y', 'ɩ': 'i',
+	// Cyrillic
+	'А': 'A', 'В': 'B', 'Е': 'E', 'К': 'K', 'М': 'M', 'Н': 'H', 'О': 'O',
+	'Р': 'P', 'С': 'C', 'Т': 'T', 'Х': 'X', 'Ѕ': 'S', 'І': 'l', 'Ј': 'J',
+	'Ү': 'Y', 'Ԛ': 'Q', 'Ԝ': 'W', 'Ѵ': 'V', 'Ӏ': 'l', 'ӏ': 'l',
+	'а': 'a', 'е': 'e', 'о': 'o', 'р': 'p', 'с': 'c', 'у': 'y', 'х': 'x',
+	'ѕ': 's', 'і': 'i', 'ј': 'j', 'һ': 'h', 'ԁ': 'd', 'ԛ': 'q', 'ԝ': 'w',
+	'ѵ': 'v', 'ү': 'y',
+	// Greek
+	'Α': 'A', 'Β': 'B', 'Ε': 'E', 'Ζ': 'Z', 'Η': 'H', 'Ι': 'l', 'Κ': 'K',
+	'Μ': 'M', 'Ν': 'N', 'Ο': 'O', 'Ρ': 'P', 'Τ': 'T', 'Υ': 'Y', 'Χ': 'X',
+	'α': 'a', 'ο': 'o', 'ν': 'v', 'ρ': 'p', 'ι': 'i', 'υ': 'u', 'κ': 'k',
+}
+
+// confusableSequences are replaced after mapping single characters
+var confusableSequences = strings.NewReplacer("rn", "m", "vv", "w")
+
+// nicknameSkeleton returns the form of nickname that is used to compare it
+// with other nicknames.
+func nicknameSkeleton(nickname string) string {
+	decomposed := norm.NFKD.String(nickname)
+	re := make([]rune, 0, len(decomposed))
+	space := false
+	for _, r := range decomposed {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		} else if unicode.IsSpace(r) {
+			// Runs of spaces collapse to one
+			if !space && len(re) > 0 {
+				re = append(re, ' ')
+			}
+			space = true
+			continue
+		}
+		space = false
+		if c, ok := confusableRunes[r]; ok {
+			r = c
+		}
+		re = append(re, r)
+	}
+	return confusableSequences.Replace(strings.TrimRight(string(re), " "))
+}
+
+// nicknamesConfusable returns true if two nicknames look alike
+func nicknamesConfusable(a, b string) bool {
+	return nicknameSkeleton(a) == nicknameSkeleton(b)
+}
+
+// contactWithNickname returns a contact with a nickname that looks like
+// nickname, other than except, or nil. Assumes the mutex is held.
+func (cl *ContactList) contactWithNickname(nickname string, except *Contact) *Contact {
+	skeleton := nicknameSkeleton(nickname)
+	for _, contact := range cl.contacts {
+		if contact != except && nicknameSkeleton(contact.Nickname()) == skeleton {
+			return contact
+		}
+	}
+	return nil
+}
+
+// availableNickname returns nickname if no contact has a nickname that looks
+// like it, or otherwise adds a number to make it distinct, like "Alice (2)".
+// Assumes the mutex is held.
+func (cl *ContactList) availableNickname(nickname string) string {
+	if nickname == "" || cl.contactWithNickname(nickname, nil) == nil {
+		return nickname
+	}
+
+	for n := 2; ; n++ {
+		suffix := fmt.Sprintf(" (%d)", n)
+		base := nickname
+		for utf8.RuneCountInString(base)+len(suffix) > MaxNicknameLength {
+			_, sz := utf8.DecodeLastRuneInString(base)
+			base = base[:len(base)-sz]
+		}
+		candidate := strings.TrimSpace(base) + suffix
+		if cl.contactWithNickname(candidate, nil) == nil {
+			return candidate
+		}
+	}
+}
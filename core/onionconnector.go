@@ -14,6 +14,9 @@ type OnionConnector struct {
 	Network      *Network
 	NeverGiveUp  bool
 	AttemptCount int
+	// BackoffDelays returns the seconds to wait before each retry, and is
+	// called for every attempt. If nil, a default schedule is used.
+	BackoffDelays func() []uint32
 }
 
 // Attempt to connect to 'address', which must be a .onion address and port,
@@ -122,16 +125,19 @@ func (oc *OnionConnector) Connect(address string, c context.Context) (net.Conn,
 	}
 }
 
-var backoffDelay [7]int = [7]int{0, 30, 60, 120, 300, 600, 900}
-
 func (oc *OnionConnector) Backoff(c context.Context) error {
 	oc.AttemptCount++
 
+	backoffDelay := defaultReconnectDelays
+	if oc.BackoffDelays != nil {
+		backoffDelay = oc.BackoffDelays()
+	}
+
 	var delay int
 	if oc.AttemptCount < len(backoffDelay) {
-		delay = backoffDelay[oc.AttemptCount]
+		delay = int(backoffDelay[oc.AttemptCount])
 	} else {
-		delay = backoffDelay[len(backoffDelay)-1]
+		delay = int(backoffDelay[len(backoffDelay)-1])
 	}
 	// Jitter by +/-20%
 	delay += int(float32(delay) * (rand.Float32()*0.4 - 0.2))
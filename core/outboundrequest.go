@@ -0,0 +1,162 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"log"
+	"time"
+)
+
+// Outbound contact requests that were rejected, or failed with an error, can
+// only be retried after this long. Expired requests can be retried at once.
+const outboundRequestRetryCooldown = time.Hour
+
+// outboundRequestExpires returns the time when an outbound contact request
+// stops being sent, measured from when it was created or last retried.
+func outboundRequestExpires(request *ricochet.ContactRequest, settings *ricochet.Settings) time.Time {
+	started := request.WhenCreated
+	if request.WhenRetried != "" {
+		started = request.WhenRetried
+	}
+	when, err := time.Parse(time.RFC3339, started)
+	if err != nil {
+		when = time.Now()
+	}
+	return when.Add(settingsOutboundRequestExpiry(settings))
+}
+
+// WithdrawContactRequest removes a contact that is still an outbound contact
+// request. If the request was already delivered, the peer keeps it, but it
+// can't be accepted anymore.
+func (cl *ContactList) WithdrawContactRequest(contact *Contact) error {
+	cl.mutex.Lock()
+	if cl.contacts[contact.Address()] != contact {
+		cl.mutex.Unlock()
+		return errors.New("Not in contact list")
+	}
+	if !contact.IsRequest() {
+		cl.mutex.Unlock()
+		return errors.New("Contact request was already accepted")
+	}
+	cl.removeContact(contact)
+	cl.mutex.Unlock()
+
+	contact.Destroy()
+	return nil
+}
+
+// EditContactRequest changes the nickname and message that are sent with an
+// outbound contact request. This is only possible before the request is
+// delivered, or while it's rejected and waiting to be retried.
+func (c *Contact) EditContactRequest(fromNickname, text string) error {
+	fromNickname = NormalizeNickname(fromNickname)
+	if len(fromNickname) > 0 && !IsNicknameAcceptable(fromNickname) {
+		return errors.New("Invalid 'from' nickname")
+	}
+	if len(text) > 0 && !IsMessageAcceptable(text) {
+		return errors.New("Invalid message")
+	}
+
+	var err error
+	updateErr := c.update(func(data *ricochet.Contact) {
+		request := data.Request
+		if request == nil {
+			err = errors.New("Contact is not a contact request")
+			return
+		} else if request.WhenDelivered != "" && !request.Rejected {
+			err = errors.New("Contact request was already delivered")
+			return
+		}
+		request.FromNickname = fromNickname
+		request.Text = text
+	})
+	if err != nil {
+		return err
+	}
+	return updateErr
+}
+
+// RetryContactRequest sends an outbound contact request again after it was
+// rejected, failed, or expired. Requests that were rejected or failed can't
+// be retried until outboundRequestRetryCooldown has passed.
+//
+// For a contact who removed us, a new request is sent with fromNickname and
+// text. Otherwise, those are ignored; EditContactRequest changes them.
+func (c *Contact) RetryContactRequest(fromNickname, text string) error {
+	fromNickname = NormalizeNickname(fromNickname)
+	if len(fromNickname) > 0 && !IsNicknameAcceptable(fromNickname) {
+		return errors.New("Invalid 'from' nickname")
+	}
+	if len(text) > 0 && !IsMessageAcceptable(text) {
+		return errors.New("Invalid message")
+	}
+
+	var err error
+	updateErr := c.update(func(data *ricochet.Contact) {
+		now := time.Now().Format(time.RFC3339)
+		request := data.Request
+		if request == nil {
+			if data.Status != ricochet.Contact_REJECTED {
+				err = errors.New("Contact is not a contact request")
+				return
+			}
+			data.Request = &ricochet.ContactRequest{
+				Direction:    ricochet.ContactRequest_OUTBOUND,
+				Address:      data.Address,
+				Nickname:     data.Nickname,
+				FromNickname: fromNickname,
+				Text:         text,
+				WhenCreated:  now,
+			}
+			data.Status = ricochet.Contact_REQUEST
+			return
+		} else if !request.Rejected {
+			err = errors.New("Contact request is still being sent")
+			return
+		}
+
+		if !request.Expired {
+			whenRejected, _ := time.Parse(time.RFC3339, request.WhenRejected)
+			if wait := outboundRequestRetryCooldown - time.Since(whenRejected); wait > 0 {
+				err = fmt.Errorf("Contact request can be retried in %v", wait.Round(time.Minute))
+				return
+			}
+		}
+
+		request.Rejected = false
+		request.Expired = false
+		request.WhenRejected = ""
+		request.WhenDelivered = ""
+		request.RemoteError = ""
+		request.WhenRetried = now
+		data.Status = ricochet.Contact_REQUEST
+	})
+	if err != nil {
+		return err
+	} else if updateErr != nil {
+		return updateErr
+	}
+
+	c.restartOutbound()
+	return nil
+}
+
+// removedByPeer moves an established contact to the REJECTED state after the
+// peer reported that we aren't their contact anymore.
+func (c *Contact) removedByPeer() {
+	err := c.update(func(data *ricochet.Contact) {
+		if data.Request == nil {
+			data.Status = ricochet.Contact_REJECTED
+		}
+	})
+	if err != nil {
+		log.Printf("Saving removed contact %v failed: %v", c, err)
+	}
+}
+
+// restartOutbound signals the connection loop to start outbound connection
+// attempts again, if they're allowed and not already running.
+func (c *Contact) restartOutbound() {
+	c.AssignConnection(nil)
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"rsc.io/qr"
+)
+
+// Number of light modules around a QR code, as required by the specification
+const qrQuietZone = 4
+
+// EncodeQRCode encodes text, such as an address or contact URI, as a QR code.
+func EncodeQRCode(text string) (*qr.Code, error) {
+	return qr.Encode(text, qr.M)
+}
+
+// QRCodePNG renders a QR code as a PNG image with scale pixels per module.
+func QRCodePNG(code *qr.Code, scale int) []byte {
+	scaled := *code
+	scaled.Scale = scale
+	return scaled.PNG()
+}
+
+// QRCodeSVG renders a QR code as an SVG image, with one unit per module.
+func QRCodeSVG(code *qr.Code) []byte {
+	size := code.Size + 2*qrQuietZone
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size)
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="#fff"/><path fill="#000" d="`, size, size)
+	for y := 0; y < code.Size; y++ {
+		for x := 0; x < code.Size; x++ {
+			if code.Black(x, y) {
+				fmt.Fprintf(&buf, "M%d %dh1v1h-1z", x+qrQuietZone, y+qrQuietZone)
+			}
+		}
+	}
+	buf.WriteString(`"/></svg>`)
+	return buf.Bytes()
+}
+
+// QRCodeText renders a QR code for terminals using unicode half-block
+// characters, so that each line of text covers two rows of modules. Colors
+// are set explicitly, so that the code is readable on light and dark terminals.
+func QRCodeText(code *qr.Code) string {
+	var buf bytes.Buffer
+	start, end := -qrQuietZone, code.Size+qrQuietZone
+	for y := start; y < end; y += 2 {
+		// White foreground on a black background; light modules are drawn
+		buf.WriteString("\x1b[37;40m")
+		for x := start; x < end; x++ {
+			top, bottom := !code.Black(x, y), !code.Black(x, y+1) && y+1 < end
+			switch {
+			case top && bottom:
+				buf.WriteString("█")
+			case top:
+				buf.WriteString("▀")
+			case bottom:
+				buf.WriteString("▄")
+			default:
+				buf.WriteString(" ")
+			}
+		}
+		buf.WriteString("\x1b[0m\n")
+	}
+	return buf.String()
+}
@@ -0,0 +1,133 @@
+package core
+
+import (
+	"errors"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"log"
+	"sync"
+	"time"
+)
+
+// Limits on inbound contact requests are settings. Every open request
+// connection holds a goroutine, and every pending request is shown to the
+// user, so these protect against a peer using many onion keys to flood the
+// client.
+
+var errRequestConnectionLimit = errors.New("Too many open contact request connections")
+
+// requestLimiter enforces the limits on inbound contact requests and counts
+// the requests it refuses.
+type requestLimiter struct {
+	mutex    sync.Mutex
+	settings func() *ricochet.Settings
+
+	openConnections int
+	recentRequests  []time.Time
+	rejectedAt      map[string]time.Time
+	stats           ricochet.InboundRequestStats
+}
+
+func newRequestLimiter(settings func() *ricochet.Settings) *requestLimiter {
+	return &requestLimiter{
+		settings:   settings,
+		rejectedAt: make(map[string]time.Time),
+	}
+}
+
+// startConnection is called when a request connection from address is
+// authenticated. If it returns an error, the connection must be closed
+// immediately; otherwise, endConnection must be called when it's closed.
+func (rl *requestLimiter) startConnection(address string) error {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	if rl.openConnections >= settingsMaxRequestConnections(rl.settings()) {
+		rl.stats.RejectedConnectionLimit++
+		log.Printf("Refused contact request connection from %s: %v", address, errRequestConnectionLimit)
+		return errRequestConnectionLimit
+	}
+
+	rl.openConnections++
+	rl.stats.Connections++
+	return nil
+}
+
+func (rl *requestLimiter) endConnection() {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	rl.openConnections--
+}
+
+// inCooldown returns true if a request from address must be rejected without
+// being considered, because its last request was rejected recently.
+func (rl *requestLimiter) inCooldown(address string) bool {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	when, ok := rl.rejectedAt[address]
+	if !ok || time.Since(when) >= settingsRequestCooldown(rl.settings()) {
+		return false
+	}
+	rl.stats.RejectedCooldown++
+	log.Printf("Rejected contact request from %s: a request was rejected recently", address)
+	return true
+}
+
+// allowNewRequest is called before creating a new inbound request from address,
+// with the number of requests that are already pending. If it returns false,
+// the request must be rejected.
+func (rl *requestLimiter) allowNewRequest(address string, pending int) bool {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	settings := rl.settings()
+	if pending >= settingsMaxPendingRequests(settings) {
+		rl.stats.RejectedPendingLimit++
+		log.Printf("Rejected contact request from %s: %d requests are already pending", address, pending)
+		return false
+	}
+
+	limit, window := settingsRequestRateLimit(settings)
+	now := time.Now()
+	recent := rl.recentRequests[:0]
+	for _, when := range rl.recentRequests {
+		if now.Sub(when) < window {
+			recent = append(recent, when)
+		}
+	}
+	rl.recentRequests = recent
+	if len(rl.recentRequests) >= limit {
+		rl.stats.RejectedRateLimit++
+		log.Printf("Rejected contact request from %s: too many recent requests", address)
+		return false
+	}
+
+	rl.recentRequests = append(rl.recentRequests, now)
+	return true
+}
+
+// rejected starts the cooldown period for an address after rejecting its
+// request.
+func (rl *requestLimiter) rejected(address string) {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+
+	cooldown := settingsRequestCooldown(rl.settings())
+	now := time.Now()
+	for addr, when := range rl.rejectedAt {
+		if now.Sub(when) >= cooldown {
+			delete(rl.rejectedAt, addr)
+		}
+	}
+	rl.rejectedAt[address] = now
+}
+
+// Stats returns the current counters, except for pendingRequests, which is
+// managed by ContactList.
+func (rl *requestLimiter) Stats() ricochet.InboundRequestStats {
+	rl.mutex.Lock()
+	defer rl.mutex.Unlock()
+	stats := rl.stats
+	stats.OpenConnections = int32(rl.openConnections)
+	return stats
+}
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Number of request policy decisions kept for auditing
+const maxRequestPolicyDecisions = 200
+
+// validateRequestPolicy returns an error if policy can't be used
+func validateRequestPolicy(policy *ricochet.RequestPolicy) error {
+	if _, ok := ricochet.RequestPolicy_Mode_name[int32(policy.Mode)]; !ok {
+		return errors.New("Invalid request policy mode")
+	}
+	if policy.MessagePattern != "" {
+		if _, err := regexp.Compile(policy.MessagePattern); err != nil {
+			return fmt.Errorf("Invalid message pattern: %v", err)
+		}
+	}
+	if policy.MessageToken != "" && strings.ContainsAny(policy.MessageToken, " \t\r\n") {
+		return errors.New("Message token cannot contain spaces")
+	}
+	for _, address := range policy.AllowedAddresses {
+		if !IsAddressValid(address) {
+			return fmt.Errorf("Invalid address '%s'", address)
+		}
+	}
+	if policy.Mode == ricochet.RequestPolicy_ACCEPT_MATCHING && policy.MessagePattern == "" &&
+		policy.MessageToken == "" && len(policy.AllowedAddresses) == 0 {
+		return errors.New("Request policy has nothing to match")
+	}
+	return nil
+}
+
+// evaluateRequestPolicy returns the action for a request from address with
+// message, and the reason for it. The policy must be valid.
+func evaluateRequestPolicy(policy *ricochet.RequestPolicy, address, message string) (ricochet.RequestPolicyDecision_Action, string) {
+	switch policy.Mode {
+	case ricochet.RequestPolicy_ACCEPT_ALL:
+		return ricochet.RequestPolicyDecision_ACCEPTED, "All requests are accepted"
+	case ricochet.RequestPolicy_REJECT_ALL:
+		return ricochet.RequestPolicyDecision_REJECTED, "All requests are rejected"
+	case ricochet.RequestPolicy_ACCEPT_MATCHING:
+		for _, allowed := range policy.AllowedAddresses {
+			if address == allowed {
+				return ricochet.RequestPolicyDecision_ACCEPTED, "Address is allowed"
+			}
+		}
+		if policy.MessageToken != "" {
+			for _, word := range strings.Fields(message) {
+				if word == policy.MessageToken {
+					return ricochet.RequestPolicyDecision_ACCEPTED, "Message contains the token"
+				}
+			}
+		}
+		if policy.MessagePattern != "" {
+			if regexp.MustCompile(policy.MessagePattern).MatchString(message) {
+				return ricochet.RequestPolicyDecision_ACCEPTED, "Message matches the pattern"
+			}
+		}
+		if policy.RejectUnmatched {
+			return ricochet.RequestPolicyDecision_REJECTED, "Request did not match"
+		}
+		return ricochet.RequestPolicyDecision_QUEUED, "Request did not match"
+	}
+	return ricochet.RequestPolicyDecision_QUEUED, ""
+}
+
+// RequestPolicy returns the policy for new inbound contact requests.
+func (cl *ContactList) RequestPolicy() *ricochet.RequestPolicy {
+	if policy := cl.core.Config.Read().RequestPolicy; policy != nil {
+		return policy
+	}
+	return &ricochet.RequestPolicy{}
+}
+
+// SetRequestPolicy changes the policy for new inbound contact requests.
+func (cl *ContactList) SetRequestPolicy(policy *ricochet.RequestPolicy) error {
+	if err := validateRequestPolicy(policy); err != nil {
+		return err
+	}
+
+	config := cl.core.Config.Lock()
+	config.RequestPolicy = proto.Clone(policy).(*ricochet.RequestPolicy)
+	if err := cl.core.Config.Unlock(); err != nil {
+		return err
+	}
+
+	log.Printf("Changed request policy to %s", policy.Mode)
+	return nil
+}
+
+// RequestPolicyDecisions returns recent decisions made by the request policy,
+// oldest first.
+func (cl *ContactList) RequestPolicyDecisions() []*ricochet.RequestPolicyDecision {
+	return cl.core.Config.Read().RequestPolicyLog
+}
+
+// applyRequestPolicy answers a new inbound contact request by the request
+// policy, and records the decision. It returns the action, and the new
+// contact if it was accepted. If adding the contact fails, the request is
+// queued instead. Assumes the mutex is held.
+func (cl *ContactList) applyRequestPolicy(address, nickname, message string) (ricochet.RequestPolicyDecision_Action, *Contact) {
+	policy := cl.RequestPolicy()
+	if policy.Mode == ricochet.RequestPolicy_MANUAL {
+		return ricochet.RequestPolicyDecision_QUEUED, nil
+	}
+
+	action, reason := evaluateRequestPolicy(policy, address, message)
+	decision := &ricochet.RequestPolicyDecision{
+		Address:  address,
+		Nickname: nickname,
+		When:     time.Now().Format(time.RFC3339),
+		Action:   action,
+		Mode:     policy.Mode,
+		Reason:   reason,
+	}
+
+	var contact *Contact
+	if action == ricochet.RequestPolicyDecision_ACCEPTED {
+		contactNickname := nickname
+		if contactNickname == "" {
+			contactNickname, _ = PlainHostFromAddress(address)
+		}
+		contactNickname = cl.availableNickname(contactNickname)
+
+		var err error
+		contact, err = cl.addNewContact(&ricochet.Contact{
+			Address:     address,
+			Nickname:    contactNickname,
+			WhenCreated: time.Now().Format(time.RFC3339),
+		})
+		if err != nil {
+			log.Printf("Accepting contact request by policy failed: %v", err)
+			decision.Action = ricochet.RequestPolicyDecision_QUEUED
+			decision.Reason = fmt.Sprintf("%s, but adding the contact failed: %v", reason, err)
+		} else {
+			decision.ContactNickname = contactNickname
+		}
+	}
+
+	log.Printf("Request policy %s for %s: %s (%s)", decision.Action, address, decision.Mode, decision.Reason)
+	config := cl.core.Config.Lock()
+	config.RequestPolicyLog = append(config.RequestPolicyLog, decision)
+	if excess := len(config.RequestPolicyLog) - maxRequestPolicyDecisions; excess > 0 {
+		config.RequestPolicyLog = config.RequestPolicyLog[excess:]
+	}
+	cl.core.Config.Unlock()
+
+	return decision.Action, contact
+}
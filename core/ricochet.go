@@ -1,14 +1,15 @@
 package core
 
 import (
+	"bytes"
 	cryptorand "crypto/rand"
+	"errors"
 	"github.com/ricochet-im/ricochet-go/core/config"
+	"github.com/ricochet-im/ricochet-go/rpc"
 	"log"
 	"math"
 	"math/big"
 	"math/rand"
-	"net"
-	"os"
 )
 
 type Ricochet struct {
@@ -23,38 +24,48 @@ func (core *Ricochet) Init(conf *config.ConfigFile) (err error) {
 	core.Config = conf
 
 	core.Network = CreateNetwork()
-	core.setupNetwork()
+	core.applyNetworkSettings()
 	core.Identity, err = CreateIdentity(core)
 	return
 }
 
-func initRand() {
-	n, err := cryptorand.Int(cryptorand.Reader, big.NewInt(math.MaxInt64))
+// ReloadConfig reads the configuration from storage again and applies changes
+// that were made outside of the backend. If any of those changes are invalid
+// or can't be applied while running, such as a different identity key, the
+// reload is rejected and an error is returned.
+func (core *Ricochet) ReloadConfig() error {
+	contactList := core.Identity.ContactList()
+	prevSettings := core.Settings()
+	config, err := core.Config.Reload(func(current, loaded *ricochet.Config) error {
+		if !bytes.Equal(current.Secrets.GetServicePrivateKey(), loaded.Secrets.GetServicePrivateKey()) {
+			return errors.New("Identity key cannot be changed while running")
+		}
+		if loaded.Settings != nil {
+			if err := validateSettings(loaded.Settings); err != nil {
+				return err
+			}
+		}
+		return contactList.mergeConfig(current, loaded)
+	})
 	if err != nil {
-		log.Panicf("rng failed: %v", err)
+		log.Printf("Rejected configuration reload: %v", err)
+		return err
 	}
 
-	rand.Seed(n.Int64())
+	contactList.applyConfig(config)
+	if settings := core.Settings(); prevSettings.TorControlAddress != settings.TorControlAddress ||
+		prevSettings.TorControlPassword != settings.TorControlPassword {
+		core.applyNetworkSettings()
+	}
+	log.Printf("Reloaded configuration")
+	return nil
 }
 
-func (core *Ricochet) setupNetwork() {
-	socket := os.Getenv("TOR_CONTROL_SOCKET")
-	host := os.Getenv("TOR_CONTROL_HOST")
-	port := os.Getenv("TOR_CONTROL_PORT")
-	passwd := os.Getenv("TOR_CONTROL_PASSWD")
-
-	if socket != "" {
-		core.Network.SetControlAddress("unix:" + socket)
-	} else if host != "" {
-		if port == "" {
-			port = "9051"
-		}
-		core.Network.SetControlAddress(net.JoinHostPort(host, port))
-	} else {
-		core.Network.SetControlAddress("127.0.0.1:9051")
+func initRand() {
+	n, err := cryptorand.Int(cryptorand.Reader, big.NewInt(math.MaxInt64))
+	if err != nil {
+		log.Panicf("rng failed: %v", err)
 	}
 
-	if passwd != "" {
-		core.Network.SetControlPassword(passwd)
-	}
+	rand.Seed(n.Int64())
 }
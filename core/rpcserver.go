@@ -5,6 +5,7 @@ import (
 	"github.com/ricochet-im/ricochet-go/rpc"
 	"golang.org/x/net/context"
 	"log"
+	"time"
 )
 
 var NotImplementedError error = errors.New("Not implemented")
@@ -18,9 +19,11 @@ func (s *RpcServer) GetServerStatus(ctx context.Context, req *ricochet.ServerSta
 		return nil, errors.New("Unsupported RPC protocol version")
 	}
 
+	configStatus := s.Core.Config.Status()
 	return &ricochet.ServerStatusReply{
 		RpcVersion:    1,
 		ServerVersion: "0.0.0",
+		ConfigStatus:  &configStatus,
 	}, nil
 }
 
@@ -69,13 +72,140 @@ func (s *RpcServer) StopNetwork(ctx context.Context, req *ricochet.StopNetworkRe
 	return &status, nil
 }
 
+func (s *RpcServer) MonitorConfig(req *ricochet.MonitorConfigRequest, stream ricochet.RicochetCore_MonitorConfigServer) error {
+	monitor := s.Core.Config.EventMonitor().Subscribe(20)
+	defer s.Core.Config.EventMonitor().Unsubscribe(monitor)
+
+	// Send current configuration
+	if err := stream.Send(publicConfig(s.Core.Config.Read())); err != nil {
+		return err
+	}
+
+	for {
+		config, ok := (<-monitor).(*ricochet.Config)
+		if !ok {
+			break
+		}
+
+		if err := stream.Send(publicConfig(config)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *RpcServer) ReloadConfig(ctx context.Context, req *ricochet.ReloadConfigRequest) (*ricochet.Config, error) {
+	if err := s.Core.ReloadConfig(); err != nil {
+		return nil, err
+	}
+	return publicConfig(s.Core.Config.Read()), nil
+}
+
+// publicConfig returns a copy of a read-only configuration snapshot without
+// secrets, which are never sent to RPC clients. Invite tokens and the request
+// policy token are also removed, and invites are keyed by inviteID instead.
+// The copy is shallow, so it must also be treated as read-only.
+func publicConfig(config *ricochet.Config) *ricochet.Config {
+	re := *config
+	re.Secrets = nil
+	if re.Settings != nil {
+		re.Settings = publicSettings(re.Settings)
+	}
+	if re.Invites != nil {
+		re.Invites = make(map[string]*ricochet.Invite, len(config.Invites))
+		for token, invite := range config.Invites {
+			public := *invite
+			public.Token = ""
+			public.Link = ""
+			re.Invites[inviteID(token)] = &public
+		}
+	}
+	if re.Introductions != nil {
+		re.Introductions = make(map[string]*ricochet.Introduction, len(config.Introductions))
+		for token, introduction := range config.Introductions {
+			public := *introduction
+			public.Invite = ""
+			re.Introductions[inviteID(token)] = &public
+		}
+	}
+	if re.RequestPolicy != nil && re.RequestPolicy.MessageToken != "" {
+		policy := *re.RequestPolicy
+		policy.MessageToken = ""
+		re.RequestPolicy = &policy
+	}
+	return &re
+}
+
+// publicSettings returns a shallow copy of settings without the tor control
+// password.
+func publicSettings(settings *ricochet.Settings) *ricochet.Settings {
+	re := *settings
+	re.TorControlPassword = ""
+	return &re
+}
+
+func (s *RpcServer) GetSettings(ctx context.Context, req *ricochet.GetSettingsRequest) (*ricochet.Settings, error) {
+	return publicSettings(s.Core.Settings()), nil
+}
+
+func (s *RpcServer) UpdateSettings(ctx context.Context, req *ricochet.UpdateSettingsRequest) (*ricochet.Settings, error) {
+	if err := s.Core.UpdateSettings(req.Settings, req.ClearTorControlPassword); err != nil {
+		return nil, err
+	}
+	return publicSettings(s.Core.Settings()), nil
+}
+
 func (s *RpcServer) GetIdentity(ctx context.Context, req *ricochet.IdentityRequest) (*ricochet.Identity, error) {
 	reply := ricochet.Identity{
-		Address: s.Core.Identity.Address(),
+		Address:   s.Core.Identity.Address(),
+		Ephemeral: s.Core.Identity.IsEphemeral(),
 	}
 	return &reply, nil
 }
 
+func (s *RpcServer) GetIdentityCard(ctx context.Context, req *ricochet.IdentityCardRequest) (*ricochet.IdentityCard, error) {
+	address := s.Core.Identity.Address()
+	if address == "" {
+		return nil, errors.New("Identity is not ready yet")
+	}
+	if req.Scale > 32 {
+		return nil, errors.New("Image scale is too large")
+	}
+
+	// Parse the formatted URI to validate the parameters
+	uri, err := ParseContactURI(FormatContactURI(&ricochet.ContactURI{
+		Address:  address,
+		Nickname: req.Nickname,
+		Message:  req.Message,
+		Invite:   req.Invite,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	code, err := EncodeQRCode(uri.Uri)
+	if err != nil {
+		return nil, err
+	}
+
+	card := &ricochet.IdentityCard{Uri: uri.Uri}
+	switch req.Format {
+	case ricochet.IdentityCardRequest_PNG:
+		scale := int(req.Scale)
+		if scale == 0 {
+			scale = 8
+		}
+		card.ContentType = "image/png"
+		card.Image = QRCodePNG(code, scale)
+	case ricochet.IdentityCardRequest_SVG:
+		card.ContentType = "image/svg+xml"
+		card.Image = QRCodeSVG(code)
+	default:
+		return nil, errors.New("Unsupported image format")
+	}
+	return card, nil
+}
+
 func (s *RpcServer) MonitorContacts(req *ricochet.MonitorContactsRequest, stream ricochet.RicochetCore_MonitorContactsServer) error {
 	monitor := s.Core.Identity.ContactList().EventMonitor().Subscribe(20)
 	defer s.Core.Identity.ContactList().EventMonitor().Unsubscribe(monitor)
@@ -93,6 +223,19 @@ func (s *RpcServer) MonitorContacts(req *ricochet.MonitorContactsRequest, stream
 			return err
 		}
 	}
+	requests := s.Core.Identity.ContactList().InboundRequests()
+	for _, request := range requests {
+		data := request.Data()
+		event := &ricochet.ContactEvent{
+			Type: ricochet.ContactEvent_POPULATE,
+			Subject: &ricochet.ContactEvent_Request{
+				Request: &data,
+			},
+		}
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
 	// Terminate populate list with a null subject
 	{
 		event := &ricochet.ContactEvent{
@@ -132,8 +275,17 @@ func (s *RpcServer) AddContactRequest(ctx context.Context, req *ricochet.Contact
 	return contact.Data(), nil
 }
 
-func (s *RpcServer) UpdateContact(ctx context.Context, req *ricochet.Contact) (*ricochet.Contact, error) {
-	return nil, NotImplementedError
+func (s *RpcServer) UpdateContact(ctx context.Context, req *ricochet.UpdateContactRequest) (*ricochet.Contact, error) {
+	if req.Contact == nil {
+		return nil, errors.New("Contact is missing")
+	}
+
+	contactList := s.Core.Identity.ContactList()
+	contact, err := contactList.UpdateContact(req.Contact, req.Fields)
+	if err != nil {
+		return nil, err
+	}
+	return contact.Data(), nil
 }
 
 func (s *RpcServer) DeleteContact(ctx context.Context, req *ricochet.DeleteContactRequest) (*ricochet.DeleteContactReply, error) {
@@ -184,6 +336,201 @@ func (s *RpcServer) RejectInboundRequest(ctx context.Context, req *ricochet.Cont
 	return &ricochet.RejectInboundRequestReply{}, nil
 }
 
+func (s *RpcServer) GetContactHistory(ctx context.Context, req *ricochet.ContactHistoryRequest) (*ricochet.ContactHistory, error) {
+	contact := s.Core.Identity.ContactList().ContactByAddress(req.Address)
+	if contact == nil {
+		return nil, errors.New("Contact not found")
+	}
+	return contact.History(), nil
+}
+
+// outboundRequestContact returns the contact for an outbound contact request,
+// or a contact who removed us, which can be sent a new request
+func (s *RpcServer) outboundRequestContact(req *ricochet.ContactRequest) (*Contact, error) {
+	if req.Direction != ricochet.ContactRequest_OUTBOUND {
+		return nil, errors.New("Request must be outbound")
+	}
+	contact := s.Core.Identity.ContactList().ContactByAddress(req.Address)
+	if contact == nil || (!contact.IsRequest() && contact.Status() != ricochet.Contact_REJECTED) {
+		return nil, errors.New("Request does not exist")
+	}
+	return contact, nil
+}
+
+func (s *RpcServer) WithdrawOutboundRequest(ctx context.Context, req *ricochet.ContactRequest) (*ricochet.WithdrawOutboundRequestReply, error) {
+	contact, err := s.outboundRequestContact(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Core.Identity.ContactList().WithdrawContactRequest(contact); err != nil {
+		return nil, err
+	}
+	return &ricochet.WithdrawOutboundRequestReply{}, nil
+}
+
+func (s *RpcServer) EditOutboundRequest(ctx context.Context, req *ricochet.ContactRequest) (*ricochet.Contact, error) {
+	contact, err := s.outboundRequestContact(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := contact.EditContactRequest(req.FromNickname, req.Text); err != nil {
+		return nil, err
+	}
+	return contact.Data(), nil
+}
+
+func (s *RpcServer) RetryOutboundRequest(ctx context.Context, req *ricochet.ContactRequest) (*ricochet.Contact, error) {
+	contact, err := s.outboundRequestContact(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := contact.RetryContactRequest(req.FromNickname, req.Text); err != nil {
+		return nil, err
+	}
+	return contact.Data(), nil
+}
+
+func (s *RpcServer) GetVerificationCode(ctx context.Context, req *ricochet.VerificationCodeRequest) (*ricochet.VerificationCode, error) {
+	contact := s.Core.Identity.ContactList().ContactByAddress(req.Address)
+	if contact == nil {
+		return nil, errors.New("Contact not found")
+	}
+
+	code, err := contact.VerificationCode()
+	if err != nil {
+		return nil, err
+	}
+	return &ricochet.VerificationCode{
+		Address:  req.Address,
+		Code:     code,
+		Verified: contact.IsVerified(),
+	}, nil
+}
+
+func (s *RpcServer) MarkVerified(ctx context.Context, req *ricochet.MarkVerifiedRequest) (*ricochet.Contact, error) {
+	contact := s.Core.Identity.ContactList().ContactByAddress(req.Address)
+	if contact == nil {
+		return nil, errors.New("Contact not found")
+	}
+
+	if err := contact.SetVerified(req.Verified); err != nil {
+		return nil, err
+	}
+	return contact.Data(), nil
+}
+
+func (s *RpcServer) BlockContact(ctx context.Context, req *ricochet.BlockContactRequest) (*ricochet.BlockedContact, error) {
+	return s.Core.Identity.ContactList().Block(req.Address)
+}
+
+func (s *RpcServer) UnblockContact(ctx context.Context, req *ricochet.UnblockContactRequest) (*ricochet.UnblockContactReply, error) {
+	if err := s.Core.Identity.ContactList().Unblock(req.Address); err != nil {
+		return nil, err
+	}
+	return &ricochet.UnblockContactReply{}, nil
+}
+
+func (s *RpcServer) ListBlockedContacts(ctx context.Context, req *ricochet.ListBlockedContactsRequest) (*ricochet.ListBlockedContactsReply, error) {
+	return &ricochet.ListBlockedContactsReply{
+		Blocked: s.Core.Identity.ContactList().BlockedContacts(),
+	}, nil
+}
+
+func (s *RpcServer) GetInboundRequestStats(ctx context.Context, req *ricochet.InboundRequestStatsRequest) (*ricochet.InboundRequestStats, error) {
+	stats := s.Core.Identity.ContactList().InboundRequestStats()
+	return &stats, nil
+}
+
+func (s *RpcServer) CreateInvite(ctx context.Context, req *ricochet.CreateInviteRequest) (*ricochet.Invite, error) {
+	validFor := time.Duration(req.ValidFor) * time.Second
+	return s.Core.Identity.ContactList().CreateInvite(req.Nickname, req.MaxUses, validFor)
+}
+
+func (s *RpcServer) ListInvites(ctx context.Context, req *ricochet.ListInvitesRequest) (*ricochet.ListInvitesReply, error) {
+	return &ricochet.ListInvitesReply{
+		Invites: s.Core.Identity.ContactList().Invites(),
+	}, nil
+}
+
+func (s *RpcServer) RevokeInvite(ctx context.Context, req *ricochet.RevokeInviteRequest) (*ricochet.RevokeInviteReply, error) {
+	if err := s.Core.Identity.ContactList().RevokeInvite(req.Token); err != nil {
+		return nil, err
+	}
+	return &ricochet.RevokeInviteReply{}, nil
+}
+
+func (s *RpcServer) GetRequestPolicy(ctx context.Context, req *ricochet.GetRequestPolicyRequest) (*ricochet.RequestPolicy, error) {
+	return s.Core.Identity.ContactList().RequestPolicy(), nil
+}
+
+func (s *RpcServer) SetRequestPolicy(ctx context.Context, req *ricochet.SetRequestPolicyRequest) (*ricochet.RequestPolicy, error) {
+	if req.Policy == nil {
+		return nil, errors.New("Missing request policy")
+	}
+	contactList := s.Core.Identity.ContactList()
+	if err := contactList.SetRequestPolicy(req.Policy); err != nil {
+		return nil, err
+	}
+	return contactList.RequestPolicy(), nil
+}
+
+func (s *RpcServer) ListRequestPolicyDecisions(ctx context.Context, req *ricochet.ListRequestPolicyDecisionsRequest) (*ricochet.ListRequestPolicyDecisionsReply, error) {
+	return &ricochet.ListRequestPolicyDecisionsReply{
+		Decisions: s.Core.Identity.ContactList().RequestPolicyDecisions(),
+	}, nil
+}
+
+func (s *RpcServer) ExportContacts(ctx context.Context, req *ricochet.ExportContactsRequest) (*ricochet.ExportContactsReply, error) {
+	var selector *ContactSelector
+	if req.Selector != "" {
+		var err error
+		if selector, err = ParseContactSelector(req.Selector); err != nil {
+			return nil, err
+		}
+	}
+	records := s.Core.Identity.ContactList().ExportContacts(selector)
+	data, err := FormatContactRecords(records, req.Format)
+	if err != nil {
+		return nil, err
+	}
+	return &ricochet.ExportContactsReply{
+		Data:  data,
+		Count: int32(len(records)),
+	}, nil
+}
+
+func (s *RpcServer) ImportContacts(req *ricochet.ImportContactsRequest, stream ricochet.RicochetCore_ImportContactsServer) error {
+	records, err := ParseContactRecords(req.Data, req.Format)
+	if err != nil {
+		return err
+	}
+	interval := time.Duration(req.Interval) * time.Second
+	return s.Core.Identity.ContactList().ImportContacts(stream.Context(), records, req.FromNickname, interval, stream.Send)
+}
+
+func (s *RpcServer) IntroduceContact(ctx context.Context, req *ricochet.IntroduceContactRequest) (*ricochet.IntroduceContactReply, error) {
+	contactList := s.Core.Identity.ContactList()
+	to := contactList.ContactByAddress(req.Address)
+	if to == nil {
+		return nil, errors.New("Contact not found")
+	}
+	// Without an introduced contact, we introduce ourselves
+	var introduced *Contact
+	if req.IntroducedAddress != "" {
+		if introduced = contactList.ContactByAddress(req.IntroducedAddress); introduced == nil {
+			return nil, errors.New("Contact not found")
+		}
+	}
+	if err := contactList.IntroduceContact(to, introduced, req.Mutual); err != nil {
+		return nil, err
+	}
+	return &ricochet.IntroduceContactReply{}, nil
+}
+
+func (s *RpcServer) ParseContactURI(ctx context.Context, req *ricochet.ParseContactURIRequest) (*ricochet.ContactURI, error) {
+	return ParseContactURI(req.Uri)
+}
+
 func (s *RpcServer) MonitorConversations(req *ricochet.MonitorConversationsRequest, stream ricochet.RicochetCore_MonitorConversationsServer) error {
 	// XXX Technically there is a race between starting to monitor
 	// and the list and state of messages used to populate, that could
@@ -1,6 +1,8 @@
 package core
 
 import (
+	"golang.org/x/text/unicode/norm"
+	"strings"
 	"unicode"
 	"unicode/utf8"
 )
@@ -9,16 +11,37 @@ const (
 	// Consistent with protocol's ContactRequestChannel
 	MaxMessageLength  = 2000
 	MaxNicknameLength = 30
+	MaxTagLength      = 30
+	MaxContactTags    = 20
 )
 
+// invisibleNicknameChars are characters that are graphic, but render as
+// nothing or modify nearby characters without being seen. They could make
+// nicknames that look identical to another.
+var invisibleNicknameChars = []*unicode.RangeTable{
+	unicode.Other_Default_Ignorable_Code_Point,
+	unicode.Variation_Selector,
+	unicode.Bidi_Control,
+	unicode.Join_Control,
+	&unicode.RangeTable{R16: []unicode.Range16{{0x2800, 0x2800, 1}}},
+}
+
+// NormalizeNickname returns nickname in the form it should be validated and
+// stored: in Unicode NFC, without leading or trailing whitespace.
+func NormalizeNickname(nickname string) string {
+	return strings.TrimSpace(norm.NFC.String(nickname))
+}
+
 // IsNicknameAcceptable returns true for strings that are usable as contact nicknames.
+// Nicknames should be passed through NormalizeNickname first.
 // A nickname is acceptable if it:
 //   - Is composed of only valid UTF-8 sequences
 //   - Has between 1 and MaxNicknameLength unicode characters
-//   - Doesn't contain any characters from unicode Cf or Cc
+//   - Contains only graphic characters, which excludes unicode Cc, Cf, Co, and
+//     unassigned codepoints
+//   - Doesn't contain spaces other than U+0020, or invisible characters
+//     including bidi controls, joiners, and variation selectors
 //   - Doesn't contain any of these HTML-sensitive characters: "<>&\
-//   - XXX This could use more thought on valid codepoints; note that Go
-//     has a good set of built-in range tables in `unicode`
 func IsNicknameAcceptable(nickname string) bool {
 	length := 0
 
@@ -30,7 +53,10 @@ func IsNicknameAcceptable(nickname string) bool {
 			return false
 		}
 
-		if unicode.In(r, unicode.Cf, unicode.Cc) {
+		if !unicode.IsGraphic(r) || unicode.IsOneOf(invisibleNicknameChars, r) {
+			return false
+		}
+		if unicode.Is(unicode.Zs, r) && r != ' ' {
 			return false
 		}
 
@@ -60,3 +86,24 @@ func IsMessageAcceptable(message string) bool {
 		len(message) <= MaxMessageLength &&
 		utf8.ValidString(message)
 }
+
+// IsTagAcceptable returns true for strings that are usable as contact tags.
+// A tag is acceptable if it:
+//   - Has between 1 and MaxTagLength unicode characters
+//   - Contains only lowercase letters, digits, '-', '_', and '.'
+func IsTagAcceptable(tag string) bool {
+	length := 0
+	for _, r := range tag {
+		if !unicode.IsDigit(r) && !(unicode.IsLetter(r) && !unicode.IsUpper(r)) &&
+			r != '-' && r != '_' && r != '.' {
+			return false
+		}
+
+		length++
+		if length > MaxTagLength {
+			return false
+		}
+	}
+
+	return length > 0
+}
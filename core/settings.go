@@ -0,0 +1,260 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"log"
+	"net"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	defaultConversationBacklog   = 1000
+	maxConversationBacklog       = 100000
+	defaultRequestTimeout        = 15
+	maxRequestTimeout            = 300
+	maxReconnectDelay            = 86400
+	maxReconnectDelays           = 32
+	defaultRequestExpiry         = 30
+	maxRequestExpiry             = 3650
+	defaultOutboundRequestExpiry = 30
+	defaultMaxRequestConnections = 20
+	maxMaxRequestConnections     = 1000
+	defaultMaxPendingRequests    = 100
+	maxMaxPendingRequests        = 10000
+	defaultRequestCooldown       = 10
+	defaultRequestRateLimit      = 30
+	maxRequestRateLimit          = 10000
+	defaultRequestRateWindow     = 10
+	maxRequestLimitMinutes       = 1440
+)
+
+var defaultReconnectDelays = []uint32{0, 30, 60, 120, 300, 600, 900}
+
+// DefaultSettings returns the settings used when none are configured.
+func DefaultSettings() *ricochet.Settings {
+	return &ricochet.Settings{
+		AutoConnect:           true,
+		ConversationBacklog:   defaultConversationBacklog,
+		RequestTimeout:        defaultRequestTimeout,
+		ReconnectDelays:       append([]uint32(nil), defaultReconnectDelays...),
+		RequestExpiry:         defaultRequestExpiry,
+		OutboundRequestExpiry: defaultOutboundRequestExpiry,
+		MaxRequestConnections: defaultMaxRequestConnections,
+		MaxPendingRequests:    defaultMaxPendingRequests,
+		RequestCooldown:       defaultRequestCooldown,
+		RequestRateLimit:      defaultRequestRateLimit,
+		RequestRateWindow:     defaultRequestRateWindow,
+	}
+}
+
+// Settings returns a **read-only** snapshot of the current settings, with
+// defaults for anything that isn't configured. Settings can change at any
+// time, so components should call this when they need a value instead of
+// keeping a copy.
+func (core *Ricochet) Settings() *ricochet.Settings {
+	settings := core.Config.Read().Settings
+	if settings == nil {
+		return DefaultSettings()
+	}
+	return settings
+}
+
+// UpdateSettings validates and replaces all settings, and applies them to
+// running components. If settings has no tor control password, the current
+// password is kept unless clearPassword is set. If the settings can't be
+// saved, they are still used, like any other configuration change, and the
+// error is returned.
+func (core *Ricochet) UpdateSettings(settings *ricochet.Settings, clearPassword bool) error {
+	if err := validateSettings(settings); err != nil {
+		return err
+	}
+	settings = proto.Clone(settings).(*ricochet.Settings)
+
+	config := core.Config.Lock()
+	prev := config.Settings
+	if settings.TorControlPassword == "" && !clearPassword {
+		settings.TorControlPassword = prev.GetTorControlPassword()
+	}
+	config.Settings = settings
+	err := core.Config.Unlock()
+
+	if prev.GetTorControlAddress() != settings.TorControlAddress ||
+		prev.GetTorControlPassword() != settings.TorControlPassword {
+		core.applyNetworkSettings()
+	}
+	return err
+}
+
+func validateSettings(settings *ricochet.Settings) error {
+	if settings == nil {
+		return errors.New("Settings are missing")
+	}
+
+	if address := settings.TorControlAddress; address != "" {
+		if strings.HasPrefix(address, "unix:") {
+			if len(address) == 5 {
+				return errors.New("Invalid tor control socket path")
+			}
+		} else if _, _, err := net.SplitHostPort(address); err != nil {
+			return fmt.Errorf("Invalid tor control address: %v", err)
+		}
+	}
+	if strings.ContainsAny(settings.TorControlPassword, "\r\n") {
+		return errors.New("Invalid tor control password")
+	}
+	if settings.ConversationBacklog > maxConversationBacklog {
+		return fmt.Errorf("Conversation backlog cannot be more than %d messages", maxConversationBacklog)
+	}
+	if settings.RequestTimeout > maxRequestTimeout {
+		return fmt.Errorf("Request timeout cannot be more than %d seconds", maxRequestTimeout)
+	}
+	if len(settings.ReconnectDelays) > maxReconnectDelays {
+		return fmt.Errorf("Cannot have more than %d reconnect delays", maxReconnectDelays)
+	}
+	for _, delay := range settings.ReconnectDelays {
+		if delay > maxReconnectDelay {
+			return fmt.Errorf("Reconnect delays cannot be more than %d seconds", maxReconnectDelay)
+		}
+	}
+	if settings.RequestExpiry > maxRequestExpiry {
+		return fmt.Errorf("Request expiry cannot be more than %d days", maxRequestExpiry)
+	}
+	if settings.OutboundRequestExpiry > maxRequestExpiry {
+		return fmt.Errorf("Outbound request expiry cannot be more than %d days", maxRequestExpiry)
+	}
+	if settings.MaxRequestConnections > maxMaxRequestConnections {
+		return fmt.Errorf("Request connections cannot be more than %d", maxMaxRequestConnections)
+	}
+	if settings.MaxPendingRequests > maxMaxPendingRequests {
+		return fmt.Errorf("Pending requests cannot be more than %d", maxMaxPendingRequests)
+	}
+	if settings.RequestRateLimit > maxRequestRateLimit {
+		return fmt.Errorf("Request rate limit cannot be more than %d", maxRequestRateLimit)
+	}
+	if settings.RequestCooldown > maxRequestLimitMinutes || settings.RequestRateWindow > maxRequestLimitMinutes {
+		return fmt.Errorf("Request cooldown and rate window cannot be more than %d minutes", maxRequestLimitMinutes)
+	}
+	return nil
+}
+
+// controlSettings returns the tor control address and password to use. The
+// TOR_CONTROL_* environment variables are only used for settings that are
+// empty, so that changes to the settings always take effect.
+func (core *Ricochet) controlSettings() (address, password string) {
+	settings := core.Settings()
+	socket := os.Getenv("TOR_CONTROL_SOCKET")
+	host := os.Getenv("TOR_CONTROL_HOST")
+	port := os.Getenv("TOR_CONTROL_PORT")
+
+	if settings.TorControlAddress != "" {
+		address = settings.TorControlAddress
+	} else if socket != "" {
+		address = "unix:" + socket
+	} else if host != "" {
+		if port == "" {
+			port = "9051"
+		}
+		address = net.JoinHostPort(host, port)
+	} else {
+		address = "127.0.0.1:9051"
+	}
+
+	password = settings.TorControlPassword
+	if password == "" {
+		password = os.Getenv("TOR_CONTROL_PASSWD")
+	}
+	return
+}
+
+// applyNetworkSettings configures the network with the current tor control
+// settings. If the network is started, it is restarted to use them.
+func (core *Ricochet) applyNetworkSettings() {
+	address, password := core.controlSettings()
+	if err := core.Network.SetControlAddress(address); err == nil {
+		core.Network.SetControlPassword(password)
+		return
+	}
+
+	log.Printf("Restarting network to apply new tor control settings")
+	go func() {
+		core.Network.Stop()
+		core.Network.SetControlAddress(address)
+		core.Network.SetControlPassword(password)
+		core.Network.Start()
+	}()
+}
+
+func settingsConversationBacklog(settings *ricochet.Settings) int {
+	if settings.ConversationBacklog == 0 {
+		return defaultConversationBacklog
+	}
+	return int(settings.ConversationBacklog)
+}
+
+func settingsRequestTimeout(settings *ricochet.Settings) time.Duration {
+	if settings.RequestTimeout == 0 {
+		return defaultRequestTimeout * time.Second
+	}
+	return time.Duration(settings.RequestTimeout) * time.Second
+}
+
+func settingsReconnectDelays(settings *ricochet.Settings) []uint32 {
+	if len(settings.ReconnectDelays) == 0 {
+		return defaultReconnectDelays
+	}
+	return settings.ReconnectDelays
+}
+
+func settingsRequestExpiry(settings *ricochet.Settings) time.Duration {
+	days := settings.RequestExpiry
+	if days == 0 {
+		days = defaultRequestExpiry
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+func settingsOutboundRequestExpiry(settings *ricochet.Settings) time.Duration {
+	days := settings.OutboundRequestExpiry
+	if days == 0 {
+		days = defaultOutboundRequestExpiry
+	}
+	return time.Duration(days) * 24 * time.Hour
+}
+
+func settingsMaxRequestConnections(settings *ricochet.Settings) int {
+	if settings.MaxRequestConnections == 0 {
+		return defaultMaxRequestConnections
+	}
+	return int(settings.MaxRequestConnections)
+}
+
+func settingsMaxPendingRequests(settings *ricochet.Settings) int {
+	if settings.MaxPendingRequests == 0 {
+		return defaultMaxPendingRequests
+	}
+	return int(settings.MaxPendingRequests)
+}
+
+func settingsRequestCooldown(settings *ricochet.Settings) time.Duration {
+	minutes := settings.RequestCooldown
+	if minutes == 0 {
+		minutes = defaultRequestCooldown
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+func settingsRequestRateLimit(settings *ricochet.Settings) (int, time.Duration) {
+	limit, minutes := settings.RequestRateLimit, settings.RequestRateWindow
+	if limit == 0 {
+		limit = defaultRequestRateLimit
+	}
+	if minutes == 0 {
+		minutes = defaultRequestRateWindow
+	}
+	return int(limit), time.Duration(minutes) * time.Minute
+}
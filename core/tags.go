@@ -0,0 +1,128 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"sort"
+	"strings"
+)
+
+// normalizeTags lowercases, sorts, and removes duplicates from tags, and
+// returns an error if any of them are not acceptable.
+func normalizeTags(tags []string) ([]string, error) {
+	seen := make(map[string]bool, len(tags))
+	re := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimPrefix(tag, "#"))
+		if !IsTagAcceptable(tag) {
+			return nil, fmt.Errorf("Invalid tag '%s'", tag)
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			re = append(re, tag)
+		}
+	}
+	if len(re) > MaxContactTags {
+		return nil, fmt.Errorf("Contacts cannot have more than %d tags", MaxContactTags)
+	}
+	sort.Strings(re)
+	return re, nil
+}
+
+func (c *Contact) Tags() []string {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]string(nil), c.data.Tags...)
+}
+
+func (c *Contact) HasTag(tag string) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return hasTag(c.data, tag)
+}
+
+func hasTag(data *ricochet.Contact, tag string) bool {
+	for _, t := range data.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// ContactSelector chooses a set of contacts for features that apply to
+// several contacts at once. It's parsed from a comma-separated list, where
+// each item is either "#tag" for all contacts with that tag, a contact
+// address, or "*" for all contacts.
+type ContactSelector struct {
+	all       bool
+	tags      []string
+	addresses []string
+}
+
+func ParseContactSelector(selector string) (*ContactSelector, error) {
+	re := &ContactSelector{}
+	for _, item := range strings.Split(selector, ",") {
+		item = strings.TrimSpace(item)
+		switch {
+		case item == "*":
+			re.all = true
+		case strings.HasPrefix(item, "#"):
+			tag := strings.ToLower(item[1:])
+			if !IsTagAcceptable(tag) {
+				return nil, fmt.Errorf("Invalid tag '%s'", tag)
+			}
+			re.tags = append(re.tags, tag)
+		case IsAddressValid(item):
+			re.addresses = append(re.addresses, item)
+		case item == "":
+			return nil, errors.New("Empty contact selector")
+		default:
+			return nil, fmt.Errorf("Invalid contact selector '%s'", item)
+		}
+	}
+	return re, nil
+}
+
+// Matches returns true if the contact is selected.
+func (s *ContactSelector) Matches(data *ricochet.Contact) bool {
+	if s.all {
+		return true
+	}
+	for _, address := range s.addresses {
+		if data.Address == address {
+			return true
+		}
+	}
+	for _, tag := range s.tags {
+		if hasTag(data, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *ContactSelector) String() string {
+	var items []string
+	if s.all {
+		items = append(items, "*")
+	}
+	for _, tag := range s.tags {
+		items = append(items, "#"+tag)
+	}
+	items = append(items, s.addresses...)
+	return strings.Join(items, ",")
+}
+
+// ContactsMatching returns the contacts that are selected by selector, or all
+// contacts if it's nil.
+func (cl *ContactList) ContactsMatching(selector *ContactSelector) []*Contact {
+	var re []*Contact
+	for _, contact := range cl.Contacts() {
+		if selector == nil || selector.Matches(contact.Data()) {
+			re = append(re, contact)
+		}
+	}
+	return re
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"errors"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"net/url"
+	"strings"
+)
+
+// Contact URIs have the form "ricochet:<host>?<query>". The query has optional
+// parameters for a suggested nickname for the contact ("nickname"), a suggested
+// message for the contact request ("message"), and an invite token to include
+// in the request message ("invite"). Unknown parameters are ignored for
+// compatibility with later versions.
+const (
+	uriNicknameParam = "nickname"
+	uriMessageParam  = "message"
+	uriInviteParam   = "invite"
+)
+
+// ParseContactURI parses and validates a contact URI. A bare address is also
+// accepted, as is the form "ricochet://<host>".
+func ParseContactURI(uri string) (*ricochet.ContactURI, error) {
+	uri = strings.TrimSpace(uri)
+	if !strings.HasPrefix(uri, "ricochet:") {
+		return nil, errors.New("Not a ricochet: URI")
+	}
+
+	rest := strings.TrimPrefix(uri[len("ricochet:"):], "//")
+	host, rawQuery := rest, ""
+	if i := strings.IndexByte(rest, '?'); i >= 0 {
+		host, rawQuery = rest[:i], rest[i+1:]
+	}
+
+	address, ok := AddressFromPlainHost(strings.ToLower(host))
+	if !ok {
+		return nil, errors.New("Invalid ricochet address")
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return nil, errors.New("Invalid URI parameters")
+	}
+
+	re := &ricochet.ContactURI{
+		Address:  address,
+		Nickname: NormalizeNickname(query.Get(uriNicknameParam)),
+		Message:  query.Get(uriMessageParam),
+		Invite:   query.Get(uriInviteParam),
+	}
+	if len(re.Nickname) > 0 && !IsNicknameAcceptable(re.Nickname) {
+		return nil, errors.New("Invalid nickname in URI")
+	}
+	if len(re.Message) > 0 && !IsMessageAcceptable(re.Message) {
+		return nil, errors.New("Invalid message in URI")
+	}
+	if len(re.Invite) > 0 && !isInviteTokenValid(re.Invite) {
+		return nil, errors.New("Invalid invite in URI")
+	}
+
+	re.Uri = FormatContactURI(re)
+	return re, nil
+}
+
+// FormatContactURI returns the URI for the address and any parameters of uri.
+// The Uri field is ignored.
+func FormatContactURI(uri *ricochet.ContactURI) string {
+	query := url.Values{}
+	if uri.Nickname != "" {
+		query.Set(uriNicknameParam, uri.Nickname)
+	}
+	if uri.Message != "" {
+		query.Set(uriMessageParam, uri.Message)
+	}
+	if uri.Invite != "" {
+		query.Set(uriInviteParam, uri.Invite)
+	}
+
+	if len(query) == 0 {
+		return uri.Address
+	}
+	// Encode spaces as %20 rather than '+', which is more widely understood
+	// outside of HTML forms
+	return uri.Address + "?" + strings.Replace(query.Encode(), "+", "%20", -1)
+}
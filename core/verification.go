@@ -0,0 +1,68 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"github.com/ricochet-im/ricochet-go/rpc"
+	"strings"
+	"time"
+)
+
+const (
+	verificationCodeGroups    = 5
+	verificationCodeGroupSize = 5
+)
+
+// VerificationCode derives a code from two addresses, which is the same
+// regardless of their order. Each address is a fingerprint of the public key
+// of that identity, so matching codes confirm that both sides have the other's
+// correct key. The code has 5 groups of 5 digits, which is enough to cover the
+// 80 bits of an address.
+func VerificationCode(address1, address2 string) string {
+	if address2 < address1 {
+		address1, address2 = address2, address1
+	}
+	hash := sha256.Sum256([]byte("ricochet-verify\x00" + address1 + "\x00" + address2))
+
+	groups := make([]string, verificationCodeGroups)
+	for i := range groups {
+		// 32 bits per group, reduced to 5 digits with negligible bias
+		n := binary.BigEndian.Uint32(hash[i*4:])
+		groups[i] = fmt.Sprintf("%0*d", verificationCodeGroupSize, n%100000)
+	}
+	return strings.Join(groups, " ")
+}
+
+// VerificationCode returns the code to compare with this contact to verify
+// their identity out of band.
+func (c *Contact) VerificationCode() (string, error) {
+	address := c.core.Identity.Address()
+	if address == "" {
+		return "", errors.New("Identity is not ready yet")
+	}
+	return VerificationCode(address, c.Address()), nil
+}
+
+func (c *Contact) IsVerified() bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.data.Verified
+}
+
+// SetVerified records whether the user has confirmed the verification code
+// with this contact.
+func (c *Contact) SetVerified(verified bool) error {
+	return c.update(func(data *ricochet.Contact) {
+		if data.Verified == verified {
+			return
+		}
+		data.Verified = verified
+		if verified {
+			data.WhenVerified = time.Now().Format(time.RFC3339)
+		} else {
+			data.WhenVerified = ""
+		}
+	})
+}
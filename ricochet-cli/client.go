@@ -195,7 +195,20 @@ func (c *Client) onContactEvent(event *ricochet.ContactEvent) {
 			if contact == nil {
 				log.Printf("Ignoring contact update event for unknown contact: %v", cData)
 			} else {
+				renamed := contact.Data.Nickname != cData.Nickname
+				rejected := contact.Data.Status != ricochet.Contact_REJECTED && cData.Status == ricochet.Contact_REJECTED
 				contact.Updated(cData)
+				if renamed && Ui.CurrentContact == contact {
+					Ui.setupConversationPrompt()
+				}
+				if rejected {
+					prefix := Ui.PrefixForAddress(cData.Address)
+					if cData.Request == nil {
+						fmt.Fprintf(Ui.Stdout, "\r\x1b[31m[[\x1b[0m \x1b[1m%s\x1b[0m removed you as a contact. Type \x1b[1mrequest retry %s\x1b[0m to send a new request, or \x1b[1mdelete-contact %s\x1b[0m \x1b[31m]]\x1b[39m\n", cData.Nickname, prefix, prefix)
+					} else {
+						fmt.Fprintf(Ui.Stdout, "\r\x1b[31m[[\x1b[0m Contact request to \x1b[1m%s\x1b[0m was not accepted. Type \x1b[1mrequest %s\x1b[0m for details \x1b[31m]]\x1b[39m\n", cData.Nickname, prefix)
+					}
+				}
 			}
 
 		case ricochet.ContactEvent_DELETE:
@@ -208,6 +221,12 @@ func (c *Client) onContactEvent(event *ricochet.ContactEvent) {
 			log.Printf("Ignoring unknown contact event: %v", event)
 		}
 	} else if reqData := event.GetRequest(); reqData != nil {
+		if reqData.Rejected {
+			// Rejected requests are remembered by the backend, but don't need a response
+			delete(c.Contacts.Requests, reqData.Address)
+			return
+		}
+
 		switch event.Type {
 		case ricochet.ContactEvent_POPULATE:
 			c.Contacts.Requests[reqData.Address] = reqData
@@ -263,6 +263,27 @@ func (c *Conversation) printMessage(msg *ricochet.Message) {
 		c.Contact.Data.Nickname,
 		direction,
 		msg.Text)
+	if msg.Introduction != nil && !msg.Sender.IsSelf {
+		if msg.Introduction.Address == c.Contact.Data.Address {
+			fmt.Fprintf(Ui.Stdout, "\x1b[1m%s\x1b[0m can now be introduced to your contacts with 'introduce <contact> %s'.\n",
+				c.Contact.Data.Nickname, c.Contact.Data.Nickname)
+		} else {
+			fmt.Fprintf(Ui.Stdout, "\x1b[1m%s\x1b[0m introduced \x1b[1m%s\x1b[0m (%s). Use 'add-introduced' to send a contact request.\n",
+				c.Contact.Data.Nickname, msg.Introduction.Nickname, msg.Introduction.Address)
+		}
+	}
+}
+
+// LastIntroduction returns the most recent introduction of someone else
+// received in the conversation, or nil.
+func (c *Conversation) LastIntroduction() *ricochet.Introduction {
+	for i := len(c.messages) - 1; i >= 0; i-- {
+		if msg := c.messages[i]; msg.Introduction != nil && !msg.Sender.IsSelf &&
+			msg.Introduction.Address != c.Contact.Data.Address {
+			return msg.Introduction
+		}
+	}
+	return nil
 }
 
 func (c *Conversation) UnreadCount() int {
@@ -12,7 +12,10 @@ import (
 	"log"
 	"net"
 	"os"
+	"os/signal"
+	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 )
 
@@ -25,6 +28,7 @@ var (
 	unsafeBackend  bool
 	backendMode    bool
 	connectAuto    bool
+	ephemeral      bool
 	configPath     string = "identity.json"
 	torAddress     string
 	torPassword    string
@@ -34,6 +38,7 @@ func main() {
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage:\n")
 		fmt.Fprintf(os.Stderr, "  %s [<args>] [<identity>]\n\tStandalone client using <identity> (default \"./%s\")\n", os.Args[0], configPath)
+		fmt.Fprintf(os.Stderr, "\tIdentities named with a .db extension are stored in a database instead of JSON\n")
 		fmt.Fprintf(os.Stderr, "  %s -listen <address> [<args>] [<identity>]\n\tListen on <address> for Ricochet client frontend connections\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "  %s -attach <address> [<args>]\n\tAttach to a client backend running on <address>\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "\nArgs:\n")
@@ -45,7 +50,8 @@ func main() {
 	flag.StringVar(&backendServer, "listen", "", "Listen on `<address>` for client frontend connections")
 	flag.BoolVar(&unsafeBackend, "allow-unsafe-backend", false, "Allow a remote backend address. This is NOT RECOMMENDED and may harm your security or privacy. Do not use without a secure, trusted link")
 	flag.BoolVar(&backendMode, "only-backend", false, "Run backend without any commandline UI")
-	flag.BoolVar(&connectAuto, "connect", true, "Start connecting to the network automatically")
+	flag.BoolVar(&ephemeral, "ephemeral", false, "Use a new identity that is only kept in memory. Contacts and conversations are discarded at exit")
+	flag.BoolVar(&connectAuto, "connect", true, "Start connecting to the network automatically, overriding the autoConnect setting")
 	flag.StringVar(&torAddress, "tor-control", "", "Use the tor control port at `<address>`, which may be 'host:port' or 'unix:/path'")
 	flag.StringVar(&torPassword, "tor-control-password", "", "Use `<password>` to authenticate to the tor control port")
 	flag.Parse()
@@ -67,8 +73,15 @@ func main() {
 		} else if torAddress != "" || torPassword != "" {
 			fmt.Printf("Cannot use -tor-control with -attach, because tor connections happen on the backend\n")
 			os.Exit(1)
+		} else if ephemeral {
+			fmt.Printf("Cannot use -ephemeral with -attach, because identities are managed by the backend\n")
+			os.Exit(1)
 		}
 	}
+	if ephemeral && len(flag.Args()) > 0 {
+		fmt.Printf("Cannot use -ephemeral with an identity file, because ephemeral identities are never saved\n")
+		os.Exit(1)
+	}
 
 	// Redirect log before starting backend, unless in backend mode
 	if !backendMode {
@@ -122,12 +135,14 @@ func main() {
 
 	// Initialize data from backend and start UI command loop
 	fmt.Print("Connecting to backend...\n")
+	Ui.setupInputConfigs()
 	go func() {
 		if err := client.Initialize(); err != nil {
 			fmt.Printf("Error: %s\n", err)
 			os.Exit(1)
 		}
 		client.Block()
+		Ui.updateBasePrompt()
 		Ui.PrintStatus()
 		client.Unblock()
 	}()
@@ -172,11 +187,37 @@ func checkBackendAddressSafety(address string) error {
 	return nil
 }
 
-func startBackend() error {
-	cfg, err := config.LoadConfigFile(configPath)
+// Identities with a .db extension are stored in a transactional database,
+// which only writes changed records. Anything else is a JSON file.
+func openConfig() (*config.ConfigFile, error) {
+	if ephemeral {
+		return config.NewConfig(config.NewMemoryStorage())
+	}
+
+	var storage config.Storage
+	if filepath.Ext(configPath) == ".db" {
+		boltStorage, err := config.OpenBoltStorage(configPath)
+		if err != nil {
+			return nil, err
+		}
+		storage = boltStorage
+	} else {
+		storage = config.NewFileStorage(configPath)
+	}
+
+	cfg, err := config.LoadConfig(storage)
 	if err != nil && os.IsNotExist(err) {
-		cfg, err = config.NewConfigFile(configPath)
+		cfg, err = config.NewConfig(storage)
 	}
+	if err != nil {
+		storage.Close()
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func startBackend() error {
+	cfg, err := openConfig()
 	if err != nil {
 		return err
 	}
@@ -226,11 +267,31 @@ func startBackend() error {
 		}
 	}()
 
+	// Unless the flag was given, use the autoConnect setting
+	connectFlagSet := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "connect" {
+			connectFlagSet = true
+		}
+	})
+	if !connectFlagSet {
+		connectAuto = core.Settings().AutoConnect
+	}
+
 	if connectAuto {
 		go func() {
 			core.Network.Start()
 		}()
 	}
 
+	// Apply changes made to the identity file on SIGHUP
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, syscall.SIGHUP)
+		for range signals {
+			core.ReloadConfig()
+		}
+	}()
+
 	return nil
 }
@@ -8,6 +8,10 @@ import (
 	"github.com/ricochet-im/ricochet-go/rpc"
 	"golang.org/x/net/context"
 	"io"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -31,7 +35,6 @@ type UI struct {
 }
 
 func (ui *UI) CommandLoop() {
-	ui.setupInputConfigs()
 	ui.Input.SetConfig(ui.baseConfig)
 
 	for {
@@ -54,6 +57,18 @@ func (ui *UI) setupInputConfigs() {
 	ui.baseChatConfig.UniqueEditLine = true
 }
 
+// updateBasePrompt marks the command prompt when the backend's identity is
+// ephemeral. It's called once the identity is known.
+func (ui *UI) updateBasePrompt() {
+	if !ui.Client.Identity.Ephemeral {
+		return
+	}
+	ui.baseConfig.Prompt = "\x1b[33m(ephemeral)\x1b[39m > "
+	if ui.CurrentContact == nil {
+		ui.Input.SetPrompt(ui.baseConfig.Prompt)
+	}
+}
+
 func (ui *UI) Execute(line string) error {
 	// Block client event handlers for threadsafety
 	ui.Client.Block()
@@ -92,7 +107,34 @@ func (ui *UI) Execute(line string) error {
 		}
 
 	case "contacts":
-		ui.ListContacts()
+		ui.ListContacts(words[1:])
+
+	case "info":
+		ui.ContactInfo(words[1:])
+
+	case "note":
+		ui.ContactNotes(words[1:])
+
+	case "seen":
+		ui.ContactHistory(words[1:])
+
+	case "meta":
+		ui.ContactMetadata(words[1:])
+
+	case "tags":
+		ui.ListTags()
+
+	case "tag":
+		ui.TagContact(words[1:])
+
+	case "broadcast":
+		ui.Broadcast(words[1:])
+
+	case "export":
+		ui.ExportContacts(words[1:])
+
+	case "import":
+		ui.ImportContacts(words[1:])
 
 	case "add-contact":
 		ui.AddContact(words[1:])
@@ -100,195 +142,1397 @@ func (ui *UI) Execute(line string) error {
 	case "delete-contact":
 		ui.DeleteContact(words[1:])
 
+	case "block":
+		ui.BlockContact(words[1:])
+
+	case "unblock":
+		ui.UnblockContact(words[1:])
+
+	case "blocked":
+		ui.ListBlocked()
+
+	case "verify":
+		ui.VerifyContact(words[1:])
+
+	case "id":
+		ui.ShowIdentity(words[1:])
+
+	case "invite":
+		ui.Invite(words[1:])
+
+	case "introduce":
+		ui.IntroduceContact(words[1:])
+
+	case "add-introduced":
+		ui.AddIntroducedContact(words[1:])
+
+	case "policy":
+		ui.RequestPolicy(words[1:])
+
+	case "rename":
+		ui.RenameContact(words[1:])
+
+	case "hide":
+		ui.HideContact(words[1:], true)
+
+	case "unhide":
+		ui.HideContact(words[1:], false)
+
+	case "request":
+		ui.OutboundRequest(words[1:])
+
+	case "settings":
+		ui.Settings(words[1:])
+
+	case "reload-config":
+		_, err := ui.Client.Backend.ReloadConfig(context.Background(), &ricochet.ReloadConfigRequest{})
+		if err != nil {
+			fmt.Fprintf(ui.Stdout, "reload config error: %v\n", err)
+		} else {
+			fmt.Fprintf(ui.Stdout, "configuration reloaded\n")
+		}
+
 	case "log":
 		fmt.Fprint(ui.Stdout, LogBuffer.String())
 
-	case "close":
-		ui.SetCurrentContact(nil)
+	case "close":
+		ui.SetCurrentContact(nil)
+
+	case "help":
+		ui.printHelp()
+
+	default:
+		contact, request := ui.EntityByPrefix(line)
+		if contact != nil {
+			ui.SetCurrentContact(contact)
+		} else if request != nil {
+			ui.ShowContactRequest(request)
+		} else {
+			ui.printHelp()
+		}
+	}
+
+	return nil
+}
+
+func (ui *UI) printHelp() {
+	fmt.Fprintf(ui.Stdout, "Commands: clear, quit, status, connect, disconnect, contacts, info, seen, note, meta, tags, tag, broadcast, export, import, add-contact, delete-contact, verify, id, rename, hide, unhide, request, block, unblock, blocked, invite, introduce, add-introduced, policy, settings, reload-config, log, close, help\n")
+}
+
+func (ui *UI) PrintStatus() {
+	controlStatus := ui.Client.NetworkControlStatus()
+	connectionStatus := ui.Client.NetworkConnectionStatus()
+
+	switch controlStatus.Status {
+	case ricochet.TorControlStatus_STOPPED:
+		fmt.Fprintf(ui.Stdout, "Network is stopped -- type 'connect' to go online\n")
+
+	case ricochet.TorControlStatus_ERROR:
+		fmt.Fprintf(ui.Stdout, "Network error: %s\n", controlStatus.ErrorMessage)
+
+	case ricochet.TorControlStatus_CONNECTING:
+		fmt.Fprintf(ui.Stdout, "Network connecting...\n")
+
+	case ricochet.TorControlStatus_CONNECTED:
+		switch connectionStatus.Status {
+		case ricochet.TorConnectionStatus_UNKNOWN:
+			fallthrough
+		case ricochet.TorConnectionStatus_OFFLINE:
+			fmt.Fprintf(ui.Stdout, "Network is offline\n")
+
+		case ricochet.TorConnectionStatus_BOOTSTRAPPING:
+			fmt.Fprintf(ui.Stdout, "Network bootstrapping: %s\n", connectionStatus.BootstrapProgress)
+
+		case ricochet.TorConnectionStatus_READY:
+			fmt.Fprintf(ui.Stdout, "Network is online\n")
+		}
+	}
+
+	fmt.Fprintf(ui.Stdout, "Your ricochet ID is %s\n", ui.Client.Identity.Address)
+	if ui.Client.Identity.Ephemeral {
+		fmt.Fprintf(ui.Stdout, "\x1b[33mThis identity is ephemeral:\x1b[39m it will be forgotten with all contacts and conversations when the backend exits\n")
+	}
+
+	serverStatus, err := ui.Client.Backend.GetServerStatus(context.Background(), &ricochet.ServerStatusRequest{
+		RpcVersion: 1,
+	})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "server status error: %v\n", err)
+	} else if configStatus := serverStatus.ConfigStatus; configStatus != nil {
+		if configStatus.SaveError != "" {
+			fmt.Fprintf(ui.Stdout, "\x1b[31mWarning:\x1b[39m changes are not being saved: %s\n", configStatus.SaveError)
+		}
+		if configStatus.RecoveredFrom != "" {
+			fmt.Fprintf(ui.Stdout, "\x1b[31mWarning:\x1b[39m identity was damaged and recovered from %s\n", configStatus.RecoveredFrom)
+		}
+	}
+
+	var nContacts, nOnline int
+	for _, contact := range ui.Client.Contacts.Contacts {
+		nContacts++
+		if contact.Data.Status == ricochet.Contact_ONLINE {
+			nOnline++
+		}
+	}
+	if nContacts > 0 {
+		fmt.Fprintf(ui.Stdout, "%d of %d contacts are online\n", nOnline, nContacts)
+	} else {
+		fmt.Fprintf(ui.Stdout, "You have no contacts :(\n")
+	}
+
+	if stats, err := ui.Client.Backend.GetInboundRequestStats(context.Background(), &ricochet.InboundRequestStatsRequest{}); err == nil {
+		refused := stats.RejectedConnectionLimit + stats.RejectedCooldown + stats.RejectedPendingLimit + stats.RejectedRateLimit
+		if refused > 0 {
+			fmt.Fprintf(ui.Stdout, "\x1b[31mWarning:\x1b[39m %d contact requests were refused by flood limits\n", refused)
+		}
+	}
+
+	if reqs := len(ui.Client.Contacts.Requests); reqs > 0 {
+		plural := ""
+		if reqs > 1 {
+			plural = "s"
+		}
+		fmt.Fprintf(ui.Stdout, "%d new contact request%s are waiting\n", reqs, plural)
+	}
+}
+
+// ListContacts shows contacts by status, or only those matching a selector
+// such as "#work"
+func (ui *UI) ListContacts(params []string) {
+	var selector *core.ContactSelector
+	if len(params) > 0 {
+		var err error
+		if selector, err = core.ParseContactSelector(params[0]); err != nil {
+			fmt.Fprintf(ui.Stdout, "Usage: contacts [#tag]: %s\n", err)
+			return
+		}
+	}
+
+	byStatus := make(map[ricochet.Contact_Status][]*Contact)
+	for _, contact := range ui.Client.Contacts.Contacts {
+		if selector != nil && !selector.Matches(contact.Data) {
+			continue
+		}
+		byStatus[contact.Data.Status] = append(byStatus[contact.Data.Status], contact)
+	}
+
+	order := []ricochet.Contact_Status{ricochet.Contact_ONLINE, ricochet.Contact_UNKNOWN, ricochet.Contact_OFFLINE, ricochet.Contact_REQUEST, ricochet.Contact_REJECTED}
+	for _, status := range order {
+		contacts := byStatus[status]
+		if len(contacts) == 0 {
+			continue
+		}
+		fmt.Fprintf(ui.Stdout, "%s\n", ColoredContactStatus(status))
+		for _, contact := range contacts {
+			verified := ""
+			if contact.Data.Verified {
+				verified = " \x1b[32m(verified)\x1b[39m"
+			}
+			if contact.Data.Hidden {
+				verified += " \x1b[33m(hidden)\x1b[39m"
+			}
+			if len(contact.Data.Tags) > 0 {
+				verified += " \x1b[36m#" + strings.Join(contact.Data.Tags, " #") + "\x1b[39m"
+			}
+			unreadCount := contact.Conversation.UnreadCount()
+			if unreadCount > 0 {
+				fmt.Fprintf(ui.Stdout, "    \x1b[1m%s\x1b[0m (\x1b[1m%s\x1b[0m)%s -- \x1b[34;1m%d new messages\x1b[0m\n", contact.Data.Nickname, ui.PrefixForAddress(contact.Data.Address), verified, unreadCount)
+			} else {
+				fmt.Fprintf(ui.Stdout, "    %s (\x1b[1m%s\x1b[0m)%s\n", contact.Data.Nickname, ui.PrefixForAddress(contact.Data.Address), verified)
+			}
+		}
+	}
+
+	if len(ui.Client.Contacts.Requests) > 0 && selector == nil {
+		fmt.Fprintf(ui.Stdout, "\x1b[contact requests received\x1b[39m\n")
+		for _, request := range ui.Client.Contacts.Requests {
+			fmt.Fprintf(ui.Stdout, "    %s (\x1b[1m%s\x1b[0m)\n", request.Address, ui.PrefixForAddress(request.Address))
+		}
+	}
+}
+
+func (ui *UI) AddContact(params []string) {
+	var address string
+
+	if len(params) > 0 {
+		address = params[0]
+	} else {
+		str, err := readline.Line("Contact address: ")
+		if err != nil {
+			return
+		}
+		address = str
+	}
+
+	// Addresses and links are both parsed as URIs, which may fill in the request
+	uri, err := ui.Client.Backend.ParseContactURI(context.Background(),
+		&ricochet.ParseContactURIRequest{Uri: address})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Invalid address: %s\n", err)
+		return
+	}
+	address = uri.Address
+
+	nickname := uri.Nickname
+	if nickname == "" {
+		if nickname, err = readline.Line("Nickname: "); err != nil {
+			return
+		}
+	} else {
+		fmt.Fprintf(ui.Stdout, "Nickname: %s\n", nickname)
+	}
+	fromNickname, err := readline.Line("From (your nickname): ")
+	if err != nil {
+		return
+	}
+	message := uri.Message
+	if message == "" {
+		if message, err = readline.Line("Message: "); err != nil {
+			return
+		}
+	} else {
+		fmt.Fprintf(ui.Stdout, "Message: %s\n", message)
+	}
+	if uri.Invite != "" {
+		message = core.MessageWithInviteToken(message, uri.Invite)
+	}
+
+	contact, err := ui.Client.Backend.AddContactRequest(context.Background(),
+		&ricochet.ContactRequest{
+			Direction:    ricochet.ContactRequest_OUTBOUND,
+			Address:      address,
+			Nickname:     nickname,
+			Text:         message,
+			FromNickname: fromNickname,
+		})
+
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+
+	fmt.Fprintf(ui.Stdout, "Added contact \x1b[1m%s\x1b[0m (\x1b[1m%s\x1b[0m)\n", contact.Nickname, contact.Address)
+}
+
+func (ui *UI) DeleteContact(params []string) {
+	if len(params) < 1 {
+		fmt.Fprintf(ui.Stdout, "Usage: delete-contact [address]\n")
+		return
+	}
+	contact := ui.Client.Contacts.ByAddress(params[0])
+	if contact == nil {
+		contact, _ = ui.EntityByPrefix(params[0])
+	}
+	if contact == nil {
+		fmt.Fprintf(ui.Stdout, "No contact with address %s\n", params[0])
+		return
+	}
+
+	fmt.Fprintf(ui.Stdout, "\nThis contact will be \x1b[31mdeleted\x1b[0m:\n\n")
+	fmt.Fprintf(ui.Stdout, "    Address:\t%s\n", contact.Data.Address)
+	fmt.Fprintf(ui.Stdout, "    Name:\t%s\n", contact.Data.Nickname)
+	fmt.Fprintf(ui.Stdout, "    Online:\t%s\n", contact.Data.LastConnected)
+	fmt.Fprintf(ui.Stdout, "    Created:\t%s\n\n", contact.Data.WhenCreated)
+	confirm, err := readline.Line("Type YES to confirm: ")
+	if err != nil || confirm != "YES" {
+		fmt.Fprintf(ui.Stdout, "Aborted\n")
+		return
+	}
+
+	_, err = ui.Client.Backend.DeleteContact(context.Background(),
+		&ricochet.DeleteContactRequest{Address: contact.Data.Address})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+
+	fmt.Fprintf(ui.Stdout, "Contact deleted\n")
+}
+
+// BlockContact blocks an address, which may also be given as a contact
+func (ui *UI) BlockContact(params []string) {
+	if len(params) < 1 {
+		fmt.Fprintf(ui.Stdout, "Usage: block <address>\n")
+		return
+	}
+
+	address := params[0]
+	contact := ui.Client.Contacts.ByAddress(address)
+	var request *ricochet.ContactRequest
+	if contact == nil {
+		contact, request = ui.EntityByPrefix(address)
+	}
+	if contact != nil {
+		address = contact.Data.Address
+		fmt.Fprintf(ui.Stdout, "Blocking will also delete the contact \x1b[1m%s\x1b[0m\n", contact.Data.Nickname)
+		confirm, err := readline.Line("Type YES to confirm: ")
+		if err != nil || confirm != "YES" {
+			fmt.Fprintf(ui.Stdout, "Aborted\n")
+			return
+		}
+	} else if request != nil {
+		address = request.Address
+	}
+
+	_, err := ui.Client.Backend.BlockContact(context.Background(),
+		&ricochet.BlockContactRequest{Address: address})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+	fmt.Fprintf(ui.Stdout, "Blocked %s\n", address)
+}
+
+func (ui *UI) UnblockContact(params []string) {
+	if len(params) < 1 {
+		fmt.Fprintf(ui.Stdout, "Usage: unblock <address>\n")
+		return
+	}
+
+	_, err := ui.Client.Backend.UnblockContact(context.Background(),
+		&ricochet.UnblockContactRequest{Address: params[0]})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+	fmt.Fprintf(ui.Stdout, "Unblocked %s\n", params[0])
+}
+
+func (ui *UI) ListBlocked() {
+	reply, err := ui.Client.Backend.ListBlockedContacts(context.Background(),
+		&ricochet.ListBlockedContactsRequest{})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+
+	if len(reply.Blocked) == 0 {
+		fmt.Fprintf(ui.Stdout, "No addresses are blocked\n")
+		return
+	}
+	for _, blocked := range reply.Blocked {
+		fmt.Fprintf(ui.Stdout, "    %s (blocked %s)\n", blocked.Address, blocked.WhenBlocked)
+	}
+}
+
+// VerifyContact shows the verification code for a contact, and records whether
+// the contact confirmed the same code. In a conversation, the contact may be
+// omitted to verify the current contact.
+func (ui *UI) VerifyContact(params []string) {
+	contact := ui.CurrentContact
+	if len(params) > 0 {
+		contact = ui.Client.Contacts.ByAddress(params[0])
+		if contact == nil {
+			contact, _ = ui.EntityByPrefix(params[0])
+		}
+	}
+	if contact == nil {
+		fmt.Fprintf(ui.Stdout, "Usage: verify [contact]\n")
+		return
+	}
+
+	code, err := ui.Client.Backend.GetVerificationCode(context.Background(),
+		&ricochet.VerificationCodeRequest{Address: contact.Data.Address})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+
+	fmt.Fprintf(ui.Stdout, "Verification code for \x1b[1m%s\x1b[0m:\n\n    \x1b[1m%s\x1b[0m\n\n", contact.Data.Nickname, code.Code)
+	if code.Verified {
+		fmt.Fprintf(ui.Stdout, "This contact was verified %s\n", contact.Data.WhenVerified)
+	}
+	fmt.Fprintf(ui.Stdout, "Compare this code with your contact in person or over another trusted channel.\n")
+	confirm, err := readline.Line("Type YES if the codes match, or NO if they don't: ")
+	if err != nil {
+		return
+	}
+
+	var verified bool
+	switch confirm {
+	case "YES":
+		verified = true
+	case "NO":
+		verified = false
+		fmt.Fprintf(ui.Stdout, "\x1b[31mWarning:\x1b[39m the address you added may not belong to this person\n")
+	default:
+		fmt.Fprintf(ui.Stdout, "Unchanged\n")
+		return
+	}
+
+	_, err = ui.Client.Backend.MarkVerified(context.Background(),
+		&ricochet.MarkVerifiedRequest{Address: contact.Data.Address, Verified: verified})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+	if verified {
+		fmt.Fprintf(ui.Stdout, "Contact verified\n")
+	}
+}
+
+// ShowIdentity prints our address, or "id qr" shows it as a QR code
+func (ui *UI) ShowIdentity(params []string) {
+	address := ui.Client.Identity.Address
+	if address == "" {
+		fmt.Fprintf(ui.Stdout, "Identity is not ready yet\n")
+		return
+	}
+
+	if len(params) == 0 {
+		fmt.Fprintf(ui.Stdout, "Your ricochet ID is %s\n", address)
+		return
+	} else if params[0] != "qr" {
+		fmt.Fprintf(ui.Stdout, "Usage: id [qr]\n")
+		return
+	}
+
+	code, err := core.EncodeQRCode(address)
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+	fmt.Fprint(ui.Stdout, core.QRCodeText(code))
+	fmt.Fprintf(ui.Stdout, "%s\n", address)
+}
+
+// Invite creates a single-use invite that is valid for a week, with an optional
+// nickname for the contact. "invite list" and "invite revoke <token>" manage
+// existing invites.
+func (ui *UI) Invite(params []string) {
+	var words []string
+	if len(params) > 0 {
+		words = strings.SplitN(params[0], " ", 2)
+	}
+
+	if len(words) > 0 && words[0] == "list" {
+		reply, err := ui.Client.Backend.ListInvites(context.Background(), &ricochet.ListInvitesRequest{})
+		if err != nil {
+			fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+			return
+		}
+		if len(reply.Invites) == 0 {
+			fmt.Fprintf(ui.Stdout, "No invites\n")
+		}
+		for _, invite := range reply.Invites {
+			fmt.Fprintf(ui.Stdout, "    %s (%s) used %d times, expires %s\n", invite.Token, invite.Nickname, invite.Uses, invite.WhenExpires)
+		}
+		return
+	} else if len(words) > 0 && words[0] == "revoke" {
+		if len(words) < 2 {
+			fmt.Fprintf(ui.Stdout, "Usage: invite revoke <token>\n")
+			return
+		}
+		_, err := ui.Client.Backend.RevokeInvite(context.Background(), &ricochet.RevokeInviteRequest{Token: words[1]})
+		if err != nil {
+			fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+			return
+		}
+		fmt.Fprintf(ui.Stdout, "Invite revoked\n")
+		return
+	}
+
+	nickname := ""
+	if len(params) > 0 {
+		nickname = params[0]
+	}
+	invite, err := ui.Client.Backend.CreateInvite(context.Background(),
+		&ricochet.CreateInviteRequest{
+			Nickname: nickname,
+			MaxUses:  1,
+			ValidFor: 7 * 24 * 60 * 60,
+		})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+	fmt.Fprintf(ui.Stdout, "Share this link to be added as a contact automatically:\n    \x1b[1m%s\x1b[0m\n", invite.Link)
+}
+
+// IntroduceContact sends a contact an introduction to another contact, given
+// as "<contact> <other contact> [mutual]". A mutual introduction also sends
+// the other contact an introduction back. "<contact> me" introduces ourselves
+// with an invite, which the contact can pass on when introducing us.
+func (ui *UI) IntroduceContact(params []string) {
+	var words []string
+	if len(params) > 0 {
+		words = strings.Fields(params[0])
+	}
+	if len(words) < 2 || len(words) > 3 || (len(words) == 3 && words[2] != "mutual") {
+		fmt.Fprintf(ui.Stdout, "Usage: introduce <contact> <other contact|me> [mutual]\n")
+		return
+	}
+	to := ui.contactByArg(words[0])
+	if to == nil {
+		fmt.Fprintf(ui.Stdout, "Failed: Contact not found\n")
+		return
+	}
+	req := &ricochet.IntroduceContactRequest{
+		Address: to.Data.Address,
+		Mutual:  len(words) == 3,
+	}
+	introducedNickname := "yourself"
+	if words[1] != "me" {
+		introduced := ui.contactByArg(words[1])
+		if introduced == nil {
+			fmt.Fprintf(ui.Stdout, "Failed: Contact not found\n")
+			return
+		}
+		req.IntroducedAddress = introduced.Data.Address
+		introducedNickname = introduced.Data.Nickname
+	}
+
+	if _, err := ui.Client.Backend.IntroduceContact(context.Background(), req); err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+	fmt.Fprintf(ui.Stdout, "Introduced %s to %s\n", introducedNickname, to.Data.Nickname)
+}
+
+// AddIntroducedContact sends a contact request to the contact most recently
+// introduced by a contact. In a conversation, the contact may be omitted.
+func (ui *UI) AddIntroducedContact(params []string) {
+	var arg string
+	if len(params) > 0 {
+		arg = params[0]
+	}
+	contact := ui.contactByArg(arg)
+	if contact == nil {
+		fmt.Fprintf(ui.Stdout, "Usage: add-introduced [contact]\n")
+		return
+	}
+	introduction := contact.Conversation.LastIntroduction()
+	if introduction == nil {
+		fmt.Fprintf(ui.Stdout, "No introductions from %s\n", contact.Data.Nickname)
+		return
+	}
+	if existing := ui.Client.Contacts.ByAddress(introduction.Address); existing != nil {
+		fmt.Fprintf(ui.Stdout, "%s is already a contact, as %s\n", introduction.Address, existing.Data.Nickname)
+		return
+	}
+
+	req := &ricochet.ContactRequest{
+		Direction: ricochet.ContactRequest_OUTBOUND,
+		Address:   introduction.Address,
+		Nickname:  introduction.Nickname,
+		Text:      fmt.Sprintf("%s introduced us.", contact.Data.Nickname),
+	}
+	if introduction.Invite != "" {
+		// The invite is only accepted with a nickname for us
+		var err error
+		if req.FromNickname, err = readline.Line("From (your nickname): "); err != nil {
+			return
+		}
+		req.Text = core.MessageWithInviteToken(req.Text, introduction.Invite)
+	}
+
+	added, err := ui.Client.Backend.AddContactRequest(context.Background(), req)
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+	fmt.Fprintf(ui.Stdout, "Added contact \x1b[1m%s\x1b[0m (\x1b[1m%s\x1b[0m)\n", added.Nickname, added.Address)
+}
+
+// RequestPolicy shows or changes the policy for answering inbound contact
+// requests automatically. "policy <manual|accept-all|reject-all|matching>"
+// changes the mode; "pattern", "token", "allow", "disallow", and "unmatched"
+// change what's accepted in matching mode; "policy log" lists decisions.
+func (ui *UI) RequestPolicy(params []string) {
+	var words []string
+	if len(params) > 0 {
+		words = strings.SplitN(params[0], " ", 2)
+	}
+	if len(words) > 0 && words[0] == "log" {
+		reply, err := ui.Client.Backend.ListRequestPolicyDecisions(context.Background(), &ricochet.ListRequestPolicyDecisionsRequest{})
+		if err != nil {
+			fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+			return
+		}
+		if len(reply.Decisions) == 0 {
+			fmt.Fprintf(ui.Stdout, "No decisions\n")
+		}
+		for _, d := range reply.Decisions {
+			fmt.Fprintf(ui.Stdout, "    %s %s %s (%s): %s\n", d.When, d.Action, d.Address, d.Nickname, d.Reason)
+		}
+		return
+	}
+
+	policy, err := ui.Client.Backend.GetRequestPolicy(context.Background(), &ricochet.GetRequestPolicyRequest{})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+
+	if len(words) > 0 {
+		arg := ""
+		if len(words) > 1 {
+			arg = strings.TrimSpace(words[1])
+		}
+		switch words[0] {
+		case "manual":
+			policy.Mode = ricochet.RequestPolicy_MANUAL
+		case "accept-all":
+			policy.Mode = ricochet.RequestPolicy_ACCEPT_ALL
+		case "reject-all":
+			policy.Mode = ricochet.RequestPolicy_REJECT_ALL
+		case "matching":
+			policy.Mode = ricochet.RequestPolicy_ACCEPT_MATCHING
+		case "pattern":
+			policy.MessagePattern = arg
+		case "token":
+			policy.MessageToken = arg
+		case "allow":
+			if arg == "" {
+				fmt.Fprintf(ui.Stdout, "Usage: policy allow <address>\n")
+				return
+			}
+			policy.AllowedAddresses = append(policy.AllowedAddresses, arg)
+		case "disallow":
+			var addresses []string
+			for _, address := range policy.AllowedAddresses {
+				if address != arg {
+					addresses = append(addresses, address)
+				}
+			}
+			policy.AllowedAddresses = addresses
+		case "unmatched":
+			if arg != "queue" && arg != "reject" {
+				fmt.Fprintf(ui.Stdout, "Usage: policy unmatched <queue|reject>\n")
+				return
+			}
+			policy.RejectUnmatched = arg == "reject"
+		default:
+			fmt.Fprintf(ui.Stdout, "Usage: policy [manual|accept-all|reject-all|matching|pattern|token|allow|disallow|unmatched|log] [value]\n")
+			return
+		}
+
+		policy, err = ui.Client.Backend.SetRequestPolicy(context.Background(), &ricochet.SetRequestPolicyRequest{Policy: policy})
+		if err != nil {
+			fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+			return
+		}
+	}
+
+	fmt.Fprintf(ui.Stdout, "Request policy: %s\n", policy.Mode)
+	if policy.Mode == ricochet.RequestPolicy_ACCEPT_MATCHING {
+		fmt.Fprintf(ui.Stdout, "    Pattern:\t%s\n", policy.MessagePattern)
+		fmt.Fprintf(ui.Stdout, "    Token:\t%s\n", policy.MessageToken)
+		fmt.Fprintf(ui.Stdout, "    Allowed:\t%s\n", strings.Join(policy.AllowedAddresses, ", "))
+		if policy.RejectUnmatched {
+			fmt.Fprintf(ui.Stdout, "    Others are rejected\n")
+		} else {
+			fmt.Fprintf(ui.Stdout, "    Others are queued for review\n")
+		}
+	}
+}
+
+// RenameContact changes the nickname of a contact, given as "[contact] <nickname>".
+// In a conversation, the contact may be omitted to rename the current contact.
+func (ui *UI) RenameContact(params []string) {
+	var contact *Contact
+	var nickname string
+	if len(params) > 0 {
+		words := strings.SplitN(params[0], " ", 2)
+		if len(words) == 2 {
+			contact = ui.Client.Contacts.ByAddress(words[0])
+			if contact == nil {
+				contact, _ = ui.EntityByPrefix(words[0])
+			}
+			nickname = words[1]
+		} else if ui.CurrentContact != nil {
+			contact = ui.CurrentContact
+			nickname = words[0]
+		}
+	}
+	if contact == nil || nickname == "" {
+		fmt.Fprintf(ui.Stdout, "Usage: rename [contact] <nickname>\n")
+		return
+	}
+
+	_, err := ui.Client.Backend.UpdateContact(context.Background(),
+		&ricochet.UpdateContactRequest{
+			Contact: &ricochet.Contact{
+				Address:  contact.Data.Address,
+				Nickname: nickname,
+			},
+			Fields: []string{"nickname"},
+		})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+
+	fmt.Fprintf(ui.Stdout, "Contact renamed to \x1b[1m%s\x1b[0m\n", nickname)
+}
+
+// OutboundRequest shows the state of an outbound contact request, or changes
+// it with "withdraw", "edit", or "retry"
+func (ui *UI) OutboundRequest(params []string) {
+	var action string
+	contact := ui.CurrentContact
+	if len(params) > 0 {
+		words := strings.SplitN(params[0], " ", 2)
+		switch words[0] {
+		case "withdraw", "edit", "retry":
+			action = words[0]
+			words = words[1:]
+		}
+		if len(words) > 0 {
+			contact = ui.Client.Contacts.ByAddress(words[0])
+			if contact == nil {
+				contact, _ = ui.EntityByPrefix(words[0])
+			}
+		}
+	}
+	if contact == nil {
+		fmt.Fprintf(ui.Stdout, "Usage: request [withdraw|edit|retry] [contact]\n")
+		return
+	}
+	req := &ricochet.ContactRequest{
+		Direction: ricochet.ContactRequest_OUTBOUND,
+		Address:   contact.Data.Address,
+	}
+	request := contact.Data.Request
+	if request == nil {
+		if contact.Data.Status != ricochet.Contact_REJECTED {
+			fmt.Fprintf(ui.Stdout, "Contact request for \x1b[1m%s\x1b[0m was already accepted\n", contact.Data.Nickname)
+			return
+		} else if action != "retry" {
+			fmt.Fprintf(ui.Stdout, "\x1b[1m%s\x1b[0m removed you as a contact. Use \x1b[1mrequest retry\x1b[0m to send a new request\n", contact.Data.Nickname)
+			return
+		}
+
+		// Sending a new request to a contact who removed us
+		var err error
+		if req.FromNickname, err = readline.Line("From (your nickname): "); err != nil {
+			return
+		}
+		if req.Text, err = readline.Line("Message: "); err != nil {
+			return
+		}
+	}
+
+	var err error
+	switch action {
+	case "":
+		fmt.Fprintf(ui.Stdout, "    Address:\t%s\n", request.Address)
+		fmt.Fprintf(ui.Stdout, "    From:\t%s\n", request.FromNickname)
+		fmt.Fprintf(ui.Stdout, "    Message:\t%s\n", request.Text)
+		fmt.Fprintf(ui.Stdout, "    Sent:\t%s\n", request.WhenCreated)
+		if request.WhenRetried != "" {
+			fmt.Fprintf(ui.Stdout, "    Retried:\t%s\n", request.WhenRetried)
+		}
+		if request.WhenDelivered != "" {
+			fmt.Fprintf(ui.Stdout, "    Delivered:\t%s\n", request.WhenDelivered)
+		}
+		if request.Expired {
+			fmt.Fprintf(ui.Stdout, "    Expired:\t%s\n", request.WhenRejected)
+		} else if request.RemoteError != "" {
+			fmt.Fprintf(ui.Stdout, "    Failed:\t%s (%s)\n", request.WhenRejected, request.RemoteError)
+		} else if request.Rejected {
+			fmt.Fprintf(ui.Stdout, "    Rejected:\t%s\n", request.WhenRejected)
+		}
+		return
+
+	case "withdraw":
+		_, err = ui.Client.Backend.WithdrawOutboundRequest(context.Background(), req)
+
+	case "edit":
+		if req.FromNickname, err = readline.Line("From (your nickname): "); err != nil {
+			return
+		}
+		if req.Text, err = readline.Line("Message: "); err != nil {
+			return
+		}
+		_, err = ui.Client.Backend.EditOutboundRequest(context.Background(), req)
+
+	case "retry":
+		_, err = ui.Client.Backend.RetryOutboundRequest(context.Background(), req)
+	}
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+
+	switch action {
+	case "withdraw":
+		fmt.Fprintf(ui.Stdout, "Contact request withdrawn\n")
+	case "edit":
+		fmt.Fprintf(ui.Stdout, "Contact request changed\n")
+	case "retry":
+		fmt.Fprintf(ui.Stdout, "Sending contact request again\n")
+	}
+}
+
+// contactByArg finds a contact by address or prefix, or returns the current
+// contact if arg is empty
+func (ui *UI) contactByArg(arg string) *Contact {
+	if arg == "" {
+		return ui.CurrentContact
+	}
+	contact := ui.Client.Contacts.ByAddress(arg)
+	if contact == nil {
+		contact, _ = ui.EntityByPrefix(arg)
+	}
+	return contact
+}
+
+// ContactInfo shows everything known about a contact
+func (ui *UI) ContactInfo(params []string) {
+	var arg string
+	if len(params) > 0 {
+		arg = params[0]
+	}
+	contact := ui.contactByArg(arg)
+	if contact == nil {
+		fmt.Fprintf(ui.Stdout, "Usage: info [contact]\n")
+		return
+	}
+	data := contact.Data
+
+	fmt.Fprintf(ui.Stdout, "    Name:\t%s\n", data.Nickname)
+	fmt.Fprintf(ui.Stdout, "    Address:\t%s\n", data.Address)
+	fmt.Fprintf(ui.Stdout, "    Status:\t%s\n", ColoredContactStatus(data.Status))
+	fmt.Fprintf(ui.Stdout, "    Online:\t%s\n", data.LastConnected)
+	fmt.Fprintf(ui.Stdout, "    Created:\t%s\n", data.WhenCreated)
+	if data.Verified {
+		fmt.Fprintf(ui.Stdout, "    Verified:\t%s\n", data.WhenVerified)
+	}
+	if data.Hidden {
+		fmt.Fprintf(ui.Stdout, "    Hidden:\tyes\n")
+	}
+	if len(data.Tags) > 0 {
+		fmt.Fprintf(ui.Stdout, "    Tags:\t\x1b[36m#%s\x1b[39m\n", strings.Join(data.Tags, " #"))
+	}
+	if len(data.Metadata) > 0 {
+		keys := make([]string, 0, len(data.Metadata))
+		for key := range data.Metadata {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		fmt.Fprintf(ui.Stdout, "    Metadata:\n")
+		for _, key := range keys {
+			fmt.Fprintf(ui.Stdout, "        %s:\t%s\n", key, core.FormatMetadataValue(data.Metadata[key]))
+		}
+	}
+	if data.Notes != "" {
+		fmt.Fprintf(ui.Stdout, "    Notes:\n")
+		for _, line := range strings.Split(data.Notes, "\n") {
+			fmt.Fprintf(ui.Stdout, "        %s\n", line)
+		}
+	}
+}
+
+// ContactHistory shows recent connections to a contact and when they are
+// usually online
+func (ui *UI) ContactHistory(params []string) {
+	var arg string
+	if len(params) > 0 {
+		arg = params[0]
+	}
+	contact := ui.contactByArg(arg)
+	if contact == nil {
+		fmt.Fprintf(ui.Stdout, "Usage: seen [contact]\n")
+		return
+	}
 
-	case "help":
-		ui.printHelp()
+	history, err := ui.Client.Backend.GetContactHistory(context.Background(),
+		&ricochet.ContactHistoryRequest{Address: contact.Data.Address})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+	if len(history.Connections) == 0 {
+		fmt.Fprintf(ui.Stdout, "\x1b[1m%s\x1b[0m has not been seen online\n", contact.Data.Nickname)
+		return
+	}
 
-	default:
-		contact, request := ui.EntityByPrefix(line)
-		if contact != nil {
-			ui.SetCurrentContact(contact)
-		} else if request != nil {
-			ui.ShowContactRequest(request)
-		} else {
-			ui.printHelp()
+	// Online time in each hour of the day, in local time
+	var hours [24]time.Duration
+	var total time.Duration
+	for _, record := range history.Connections {
+		start, err := time.Parse(time.RFC3339, record.WhenConnected)
+		if err != nil {
+			continue
+		}
+		end := start.Add(time.Duration(record.Duration) * time.Second)
+		if record.WhenDisconnected == "" && !record.Interrupted {
+			end = time.Now()
+		}
+		total += end.Sub(start)
+		for t := start.Local(); t.Before(end); {
+			next := t.Truncate(time.Hour).Add(time.Hour)
+			if next.After(end) {
+				next = end
+			}
+			hours[t.Hour()] += next.Sub(t)
+			t = next
 		}
 	}
 
-	return nil
-}
+	shown := history.Connections
+	if len(shown) > 10 {
+		shown = shown[len(shown)-10:]
+	}
+	fmt.Fprintf(ui.Stdout, "Recent connections to \x1b[1m%s\x1b[0m:\n", contact.Data.Nickname)
+	for i := len(shown) - 1; i >= 0; i-- {
+		record := shown[i]
+		direction := "outbound"
+		if record.Inbound {
+			direction = "inbound"
+		}
+		var duration string
+		switch {
+		case record.Interrupted:
+			duration = "until the backend stopped"
+		case record.WhenDisconnected == "":
+			duration = "\x1b[32monline now\x1b[39m"
+		default:
+			duration = (time.Duration(record.Duration) * time.Second).String()
+		}
+		fmt.Fprintf(ui.Stdout, "    %s\t%s\t%s\n", record.WhenConnected, direction, duration)
+	}
 
-func (ui *UI) printHelp() {
-	fmt.Fprintf(ui.Stdout, "Commands: clear, quit, status, connect, disconnect, contacts, add-contact, delete-contact, log, close, help\n")
+	fmt.Fprintf(ui.Stdout, "%d connections, online for %s in total\n", len(history.Connections), total.Round(time.Minute))
+	if total > 0 {
+		// Shade each hour by its share of the busiest hour
+		shades := []rune(" ░▒▓█")
+		var max time.Duration
+		for _, d := range hours {
+			if d > max {
+				max = d
+			}
+		}
+		var bar []rune
+		for _, d := range hours {
+			bar = append(bar, shades[int(int64(d)*int64(len(shades)-1)/int64(max))])
+		}
+		fmt.Fprintf(ui.Stdout, "Usually online (local time):\n    |%s|\n     0     6     12    18\n", string(bar))
+	}
 }
 
-func (ui *UI) PrintStatus() {
-	controlStatus := ui.Client.NetworkControlStatus()
-	connectionStatus := ui.Client.NetworkConnectionStatus()
+// ContactNotes replaces the private notes for a contact; an empty line
+// removes them
+func (ui *UI) ContactNotes(params []string) {
+	var arg string
+	if len(params) > 0 {
+		arg = params[0]
+	}
+	contact := ui.contactByArg(arg)
+	if contact == nil {
+		fmt.Fprintf(ui.Stdout, "Usage: note [contact]\n")
+		return
+	}
 
-	switch controlStatus.Status {
-	case ricochet.TorControlStatus_STOPPED:
-		fmt.Fprintf(ui.Stdout, "Network is stopped -- type 'connect' to go online\n")
+	if contact.Data.Notes != "" {
+		fmt.Fprintf(ui.Stdout, "Current notes:\n%s\n", contact.Data.Notes)
+	}
+	notes, err := readline.Line("Notes (empty to remove): ")
+	if err != nil {
+		return
+	}
 
-	case ricochet.TorControlStatus_ERROR:
-		fmt.Fprintf(ui.Stdout, "Network error: %s\n", controlStatus.ErrorMessage)
+	_, err = ui.Client.Backend.UpdateContact(context.Background(),
+		&ricochet.UpdateContactRequest{
+			Contact: &ricochet.Contact{
+				Address: contact.Data.Address,
+				Notes:   notes,
+			},
+			Fields: []string{"notes"},
+		})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+	fmt.Fprintf(ui.Stdout, "Notes saved\n")
+}
 
-	case ricochet.TorControlStatus_CONNECTING:
-		fmt.Fprintf(ui.Stdout, "Network connecting...\n")
+// ContactMetadata shows the metadata fields for a contact, or sets one with
+// "<key> <value>", or removes one with "<key>"
+func (ui *UI) ContactMetadata(params []string) {
+	var words []string
+	if len(params) > 0 {
+		words = strings.SplitN(params[0], " ", 3)
+	}
+	if len(words) == 0 {
+		fmt.Fprintf(ui.Stdout, "Usage: meta <contact> [<key> [<value>]]\n")
+		return
+	}
+	contact := ui.contactByArg(words[0])
+	if contact == nil {
+		fmt.Fprintf(ui.Stdout, "No contact with address %s\n", words[0])
+		return
+	}
+	if len(words) == 1 {
+		ui.ContactInfo(words[:1])
+		return
+	}
 
-	case ricochet.TorControlStatus_CONNECTED:
-		switch connectionStatus.Status {
-		case ricochet.TorConnectionStatus_UNKNOWN:
-			fallthrough
-		case ricochet.TorConnectionStatus_OFFLINE:
-			fmt.Fprintf(ui.Stdout, "Network is offline\n")
+	metadata := make(map[string]*ricochet.MetadataValue)
+	for key, value := range contact.Data.Metadata {
+		metadata[key] = value
+	}
+	key := words[1]
+	if len(words) == 3 {
+		metadata[key] = core.ParseMetadataValue(words[2])
+	} else {
+		delete(metadata, key)
+	}
 
-		case ricochet.TorConnectionStatus_BOOTSTRAPPING:
-			fmt.Fprintf(ui.Stdout, "Network bootstrapping: %s\n", connectionStatus.BootstrapProgress)
+	_, err := ui.Client.Backend.UpdateContact(context.Background(),
+		&ricochet.UpdateContactRequest{
+			Contact: &ricochet.Contact{
+				Address:  contact.Data.Address,
+				Metadata: metadata,
+			},
+			Fields: []string{"metadata"},
+		})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+	if len(words) == 3 {
+		fmt.Fprintf(ui.Stdout, "Set %s for \x1b[1m%s\x1b[0m\n", key, contact.Data.Nickname)
+	} else {
+		fmt.Fprintf(ui.Stdout, "Removed %s from \x1b[1m%s\x1b[0m\n", key, contact.Data.Nickname)
+	}
+}
 
-		case ricochet.TorConnectionStatus_READY:
-			fmt.Fprintf(ui.Stdout, "Network is online\n")
+// ListTags shows each tag with the number of contacts that have it
+func (ui *UI) ListTags() {
+	counts := make(map[string]int)
+	for _, contact := range ui.Client.Contacts.Contacts {
+		for _, tag := range contact.Data.Tags {
+			counts[tag]++
 		}
 	}
+	if len(counts) == 0 {
+		fmt.Fprintf(ui.Stdout, "No tags -- use \x1b[1mtag <contact> +<tag>\x1b[0m to add one\n")
+		return
+	}
 
-	fmt.Fprintf(ui.Stdout, "Your ricochet ID is %s\n", ui.Client.Identity.Address)
+	tags := make([]string, 0, len(counts))
+	for tag := range counts {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	for _, tag := range tags {
+		fmt.Fprintf(ui.Stdout, "    \x1b[36m#%s\x1b[39m (%d)\n", tag, counts[tag])
+	}
+}
 
-	var nContacts, nOnline int
-	for _, contact := range ui.Client.Contacts.Contacts {
-		nContacts++
-		if contact.Data.Status == ricochet.Contact_ONLINE {
-			nOnline++
+// TagContact shows the tags of a contact, or changes them with "+tag" and "-tag"
+func (ui *UI) TagContact(params []string) {
+	var words []string
+	if len(params) > 0 {
+		words = strings.Fields(params[0])
+	}
+	contact := ui.CurrentContact
+	if len(words) > 0 && !strings.HasPrefix(words[0], "+") && !strings.HasPrefix(words[0], "-") {
+		contact = ui.Client.Contacts.ByAddress(words[0])
+		if contact == nil {
+			contact, _ = ui.EntityByPrefix(words[0])
 		}
+		words = words[1:]
 	}
-	if nContacts > 0 {
-		fmt.Fprintf(ui.Stdout, "%d of %d contacts are online\n", nOnline, nContacts)
-	} else {
-		fmt.Fprintf(ui.Stdout, "You have no contacts :(\n")
+	if contact == nil {
+		fmt.Fprintf(ui.Stdout, "Usage: tag [contact] [+tag|-tag ...]\n")
+		return
 	}
 
-	if reqs := len(ui.Client.Contacts.Requests); reqs > 0 {
-		plural := ""
-		if reqs > 1 {
-			plural = "s"
+	if len(words) == 0 {
+		if len(contact.Data.Tags) == 0 {
+			fmt.Fprintf(ui.Stdout, "\x1b[1m%s\x1b[0m has no tags\n", contact.Data.Nickname)
+		} else {
+			fmt.Fprintf(ui.Stdout, "\x1b[1m%s\x1b[0m: \x1b[36m#%s\x1b[39m\n", contact.Data.Nickname, strings.Join(contact.Data.Tags, " #"))
 		}
-		fmt.Fprintf(ui.Stdout, "%d new contact request%s are waiting\n", reqs, plural)
+		return
+	}
+
+	tags := make(map[string]bool)
+	for _, tag := range contact.Data.Tags {
+		tags[tag] = true
+	}
+	for _, word := range words {
+		if len(word) < 2 || (word[0] != '+' && word[0] != '-') {
+			fmt.Fprintf(ui.Stdout, "Usage: tag [contact] [+tag|-tag ...]\n")
+			return
+		}
+		tags[strings.ToLower(word[1:])] = word[0] == '+'
+	}
+	var newTags []string
+	for tag, set := range tags {
+		if set {
+			newTags = append(newTags, tag)
+		}
+	}
+
+	data, err := ui.Client.Backend.UpdateContact(context.Background(),
+		&ricochet.UpdateContactRequest{
+			Contact: &ricochet.Contact{
+				Address: contact.Data.Address,
+				Tags:    newTags,
+			},
+			Fields: []string{"tags"},
+		})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+
+	if len(data.Tags) == 0 {
+		fmt.Fprintf(ui.Stdout, "Removed all tags from \x1b[1m%s\x1b[0m\n", data.Nickname)
+	} else {
+		fmt.Fprintf(ui.Stdout, "Tagged \x1b[1m%s\x1b[0m: \x1b[36m#%s\x1b[39m\n", data.Nickname, strings.Join(data.Tags, " #"))
 	}
 }
 
-func (ui *UI) ListContacts() {
-	byStatus := make(map[ricochet.Contact_Status][]*Contact)
-	for _, contact := range ui.Client.Contacts.Contacts {
-		byStatus[contact.Data.Status] = append(byStatus[contact.Data.Status], contact)
+// Broadcast sends a message to each contact matching a selector such as "#work"
+func (ui *UI) Broadcast(params []string) {
+	var words []string
+	if len(params) > 0 {
+		words = strings.SplitN(params[0], " ", 2)
+	}
+	if len(words) != 2 || strings.TrimSpace(words[1]) == "" {
+		fmt.Fprintf(ui.Stdout, "Usage: broadcast <#tag> <message>\n")
+		return
+	}
+	selector, err := core.ParseContactSelector(words[0])
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
 	}
 
-	order := []ricochet.Contact_Status{ricochet.Contact_ONLINE, ricochet.Contact_UNKNOWN, ricochet.Contact_OFFLINE, ricochet.Contact_REQUEST, ricochet.Contact_REJECTED}
-	for _, status := range order {
-		contacts := byStatus[status]
-		if len(contacts) == 0 {
+	sent := 0
+	for _, contact := range ui.Client.Contacts.Contacts {
+		if contact.Data.Request != nil || contact.Data.Status == ricochet.Contact_REJECTED || !selector.Matches(contact.Data) {
 			continue
 		}
-		fmt.Fprintf(ui.Stdout, "%s\n", ColoredContactStatus(status))
-		for _, contact := range contacts {
-			unreadCount := contact.Conversation.UnreadCount()
-			if unreadCount > 0 {
-				fmt.Fprintf(ui.Stdout, "    \x1b[1m%s\x1b[0m (\x1b[1m%s\x1b[0m) -- \x1b[34;1m%d new messages\x1b[0m\n", contact.Data.Nickname, ui.PrefixForAddress(contact.Data.Address), unreadCount)
-			} else {
-				fmt.Fprintf(ui.Stdout, "    %s (\x1b[1m%s\x1b[0m)\n", contact.Data.Nickname, ui.PrefixForAddress(contact.Data.Address))
-			}
+		if err := contact.Conversation.SendMessage(words[1]); err == nil {
+			sent++
 		}
 	}
+	fmt.Fprintf(ui.Stdout, "Sent to %d contacts\n", sent)
+}
 
-	if len(ui.Client.Contacts.Requests) > 0 {
-		fmt.Fprintf(ui.Stdout, "\x1b[contact requests received\x1b[39m\n")
-		for _, request := range ui.Client.Contacts.Requests {
-			fmt.Fprintf(ui.Stdout, "    %s (\x1b[1m%s\x1b[0m)\n", request.Address, ui.PrefixForAddress(request.Address))
-		}
+// contactRecordFormat returns the format of a contacts file by its extension;
+// ".csv" is CSV, and anything else is JSON.
+func contactRecordFormat(filename string) ricochet.ContactRecordFormat {
+	if strings.ToLower(filepath.Ext(filename)) == ".csv" {
+		return ricochet.ContactRecordFormat_CSV
 	}
+	return ricochet.ContactRecordFormat_JSON
 }
 
-func (ui *UI) AddContact(params []string) {
-	var address string
-
+// ExportContacts writes contacts to a file, given as "<file> [#tag]"
+func (ui *UI) ExportContacts(params []string) {
+	var words []string
 	if len(params) > 0 {
-		address = params[0]
-	} else {
-		str, err := readline.Line("Contact address: ")
-		if err != nil {
-			return
-		}
-		address = str
+		words = strings.SplitN(params[0], " ", 2)
+	}
+	if len(words) < 1 || words[0] == "" {
+		fmt.Fprintf(ui.Stdout, "Usage: export <file.json|file.csv> [#tag]\n")
+		return
+	}
+	req := &ricochet.ExportContactsRequest{Format: contactRecordFormat(words[0])}
+	if len(words) > 1 {
+		req.Selector = strings.TrimSpace(words[1])
 	}
 
-	// XXX validate address
-
-	nickname, err := readline.Line("Nickname: ")
+	reply, err := ui.Client.Backend.ExportContacts(context.Background(), req)
 	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
 		return
 	}
-	fromNickname, err := readline.Line("From (your nickname): ")
+	if err := ioutil.WriteFile(words[0], reply.Data, 0600); err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
+		return
+	}
+	fmt.Fprintf(ui.Stdout, "Exported %d contacts to %s\n", reply.Count, words[0])
+}
+
+// ImportContacts sends contact requests to the contacts in a file, given as
+// "<file> [from nickname]". Requests are sent slowly in the background, and
+// the result of each is shown as it's sent.
+func (ui *UI) ImportContacts(params []string) {
+	var words []string
+	if len(params) > 0 {
+		words = strings.SplitN(params[0], " ", 2)
+	}
+	if len(words) < 1 || words[0] == "" {
+		fmt.Fprintf(ui.Stdout, "Usage: import <file.json|file.csv> [from nickname]\n")
+		return
+	}
+	data, err := ioutil.ReadFile(words[0])
 	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
 		return
 	}
-	message, err := readline.Line("Message: ")
+	req := &ricochet.ImportContactsRequest{
+		Format: contactRecordFormat(words[0]),
+		Data:   data,
+	}
+	if len(words) > 1 {
+		req.FromNickname = strings.TrimSpace(words[1])
+	}
+
+	stream, err := ui.Client.Backend.ImportContacts(context.Background(), req)
 	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
 		return
 	}
+	fmt.Fprintf(ui.Stdout, "Importing contacts from %s\n", words[0])
+	go func() {
+		counts := make(map[ricochet.ImportContactResult_Result]int)
+		for {
+			result, err := stream.Recv()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				fmt.Fprintf(ui.Stdout, "Import failed: %s\n", err)
+				return
+			}
+			counts[result.Result]++
+			if result.Error != "" {
+				fmt.Fprintf(ui.Stdout, "    %d: %s %s (%s): %s\n", result.Record, result.Result, result.Address, result.Nickname, result.Error)
+			} else {
+				fmt.Fprintf(ui.Stdout, "    %d: %s %s (%s)\n", result.Record, result.Result, result.Address, result.Nickname)
+			}
+		}
+		fmt.Fprintf(ui.Stdout, "Import finished: %d added, %d skipped, %d failed\n",
+			counts[ricochet.ImportContactResult_ADDED], counts[ricochet.ImportContactResult_SKIPPED],
+			counts[ricochet.ImportContactResult_FAILED])
+	}()
+}
 
-	contact, err := ui.Client.Backend.AddContactRequest(context.Background(),
-		&ricochet.ContactRequest{
-			Direction:    ricochet.ContactRequest_OUTBOUND,
-			Address:      address,
-			Nickname:     nickname,
-			Text:         message,
-			FromNickname: fromNickname,
-		})
+// HideContact appears offline to a contact, or stops doing so
+func (ui *UI) HideContact(params []string, hidden bool) {
+	var arg string
+	if len(params) > 0 {
+		arg = params[0]
+	}
+	contact := ui.contactByArg(arg)
+	if contact == nil {
+		if hidden {
+			fmt.Fprintf(ui.Stdout, "Usage: hide [contact]\n")
+		} else {
+			fmt.Fprintf(ui.Stdout, "Usage: unhide [contact]\n")
+		}
+		return
+	}
 
+	_, err := ui.Client.Backend.UpdateContact(context.Background(),
+		&ricochet.UpdateContactRequest{
+			Contact: &ricochet.Contact{
+				Address: contact.Data.Address,
+				Hidden:  hidden,
+			},
+			Fields: []string{"hidden"},
+		})
 	if err != nil {
 		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
 		return
 	}
 
-	fmt.Fprintf(ui.Stdout, "Added contact \x1b[1m%s\x1b[0m (\x1b[1m%s\x1b[0m)\n", contact.Nickname, contact.Address)
+	if hidden {
+		fmt.Fprintf(ui.Stdout, "You appear offline to \x1b[1m%s\x1b[0m; messages to them will be queued until you unhide\n", contact.Data.Nickname)
+	} else {
+		fmt.Fprintf(ui.Stdout, "\x1b[1m%s\x1b[0m can see you online again\n", contact.Data.Nickname)
+	}
 }
 
-func (ui *UI) DeleteContact(params []string) {
-	if len(params) < 1 {
-		fmt.Fprintf(ui.Stdout, "Usage: delete-contact [address]\n")
+// Settings shows the backend settings, or changes one with "<name> <value>"
+func (ui *UI) Settings(params []string) {
+	settings, err := ui.Client.Backend.GetSettings(context.Background(), &ricochet.GetSettingsRequest{})
+	if err != nil {
+		fmt.Fprintf(ui.Stdout, "settings error: %v\n", err)
 		return
 	}
-	contact := ui.Client.Contacts.ByAddress(params[0])
-	if contact == nil {
-		contact, _ = ui.EntityByPrefix(params[0])
-	}
-	if contact == nil {
-		fmt.Fprintf(ui.Stdout, "No contact with address %s\n", params[0])
+
+	if len(params) == 0 {
+		delays := make([]string, len(settings.ReconnectDelays))
+		for i, delay := range settings.ReconnectDelays {
+			delays[i] = strconv.FormatUint(uint64(delay), 10)
+		}
+		fmt.Fprintf(ui.Stdout, "    auto-connect:\t\t%v\n", settings.AutoConnect)
+		fmt.Fprintf(ui.Stdout, "    tor-control:\t\t%s\n", settings.TorControlAddress)
+		fmt.Fprintf(ui.Stdout, "    tor-control-password:\t(hidden)\n")
+		fmt.Fprintf(ui.Stdout, "    backlog:\t\t\t%d\n", settings.ConversationBacklog)
+		fmt.Fprintf(ui.Stdout, "    request-timeout:\t\t%d\n", settings.RequestTimeout)
+		fmt.Fprintf(ui.Stdout, "    reconnect-delays:\t\t%s\n", strings.Join(delays, ","))
+		fmt.Fprintf(ui.Stdout, "    request-expiry:\t\t%d\n", settings.RequestExpiry)
+		fmt.Fprintf(ui.Stdout, "    outbound-request-expiry:\t%d\n", settings.OutboundRequestExpiry)
+		fmt.Fprintf(ui.Stdout, "    request-connections:\t%d\n", settings.MaxRequestConnections)
+		fmt.Fprintf(ui.Stdout, "    pending-requests:\t\t%d\n", settings.MaxPendingRequests)
+		fmt.Fprintf(ui.Stdout, "    request-cooldown:\t\t%d\n", settings.RequestCooldown)
+		fmt.Fprintf(ui.Stdout, "    request-rate-limit:\t\t%d\n", settings.RequestRateLimit)
+		fmt.Fprintf(ui.Stdout, "    request-rate-window:\t%d\n", settings.RequestRateWindow)
 		return
 	}
 
-	fmt.Fprintf(ui.Stdout, "\nThis contact will be \x1b[31mdeleted\x1b[0m:\n\n")
-	fmt.Fprintf(ui.Stdout, "    Address:\t%s\n", contact.Data.Address)
-	fmt.Fprintf(ui.Stdout, "    Name:\t%s\n", contact.Data.Nickname)
-	fmt.Fprintf(ui.Stdout, "    Online:\t%s\n", contact.Data.LastConnected)
-	fmt.Fprintf(ui.Stdout, "    Created:\t%s\n\n", contact.Data.WhenCreated)
-	confirm, err := readline.Line("Type YES to confirm: ")
-	if err != nil || confirm != "YES" {
-		fmt.Fprintf(ui.Stdout, "Aborted\n")
+	words := strings.SplitN(params[0], " ", 2)
+	if len(words) != 2 {
+		fmt.Fprintf(ui.Stdout, "Usage: settings [<name> <value>]\n")
+		return
+	}
+	name, value := words[0], strings.TrimSpace(words[1])
+	req := &ricochet.UpdateSettingsRequest{Settings: settings}
+
+	switch name {
+	case "auto-connect":
+		settings.AutoConnect, err = strconv.ParseBool(value)
+	case "tor-control":
+		settings.TorControlAddress = value
+	case "tor-control-password":
+		settings.TorControlPassword = value
+		req.ClearTorControlPassword = value == ""
+	case "backlog":
+		settings.ConversationBacklog, err = parseUint32(value)
+	case "request-timeout":
+		settings.RequestTimeout, err = parseUint32(value)
+	case "request-expiry":
+		settings.RequestExpiry, err = parseUint32(value)
+	case "outbound-request-expiry":
+		settings.OutboundRequestExpiry, err = parseUint32(value)
+	case "request-connections":
+		settings.MaxRequestConnections, err = parseUint32(value)
+	case "pending-requests":
+		settings.MaxPendingRequests, err = parseUint32(value)
+	case "request-cooldown":
+		settings.RequestCooldown, err = parseUint32(value)
+	case "request-rate-limit":
+		settings.RequestRateLimit, err = parseUint32(value)
+	case "request-rate-window":
+		settings.RequestRateWindow, err = parseUint32(value)
+	case "reconnect-delays":
+		settings.ReconnectDelays = nil
+		for _, delayStr := range strings.Split(value, ",") {
+			var delay uint32
+			if delay, err = parseUint32(strings.TrimSpace(delayStr)); err != nil {
+				break
+			}
+			settings.ReconnectDelays = append(settings.ReconnectDelays, delay)
+		}
+	default:
+		fmt.Fprintf(ui.Stdout, "Unknown setting '%s'\n", name)
 		return
 	}
-
-	_, err = ui.Client.Backend.DeleteContact(context.Background(),
-		&ricochet.DeleteContactRequest{Address: contact.Data.Address})
 	if err != nil {
+		fmt.Fprintf(ui.Stdout, "Invalid value for %s: %v\n", name, err)
+		return
+	}
+
+	if _, err := ui.Client.Backend.UpdateSettings(context.Background(), req); err != nil {
 		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
 		return
 	}
+	fmt.Fprintf(ui.Stdout, "Setting changed\n")
+}
 
-	fmt.Fprintf(ui.Stdout, "Contact deleted\n")
+func parseUint32(value string) (uint32, error) {
+	n, err := strconv.ParseUint(value, 10, 32)
+	return uint32(n), err
 }
 
 // This type acts as a readline Listener and handles special behavior for
@@ -503,6 +1747,9 @@ func (ui *UI) ShowContactRequest(request *ricochet.ContactRequest) {
 	if len(request.Text) > 0 {
 		fmt.Fprintf(ui.Stdout, "    Message:\t%s\n", request.Text)
 	}
+	if similar := ui.Client.Contacts.ByAddress(request.SimilarContact); similar != nil {
+		fmt.Fprintf(ui.Stdout, "\n    \x1b[31mWarning:\x1b[0m this name looks like your contact %s (%s)\n", similar.Data.Nickname, similar.Data.Address)
+	}
 	fmt.Fprintf(ui.Stdout, "\n")
 	action, err := readline.Line("(a)ccept, (r)eject, or (w)ait: ")
 	if err != nil {
@@ -523,6 +1770,7 @@ func (ui *UI) ShowContactRequest(request *ricochet.ContactRequest) {
 
 	for {
 		nickname, err := readline.Line("nickname: ")
+		nickname = core.NormalizeNickname(nickname)
 		if err != nil {
 			return
 		} else if nickname == "" {
@@ -537,11 +1785,15 @@ func (ui *UI) ShowContactRequest(request *ricochet.ContactRequest) {
 		}
 	}
 
-	_, err = ui.Client.Backend.AcceptInboundRequest(context.Background(), request)
+	contact, err := ui.Client.Backend.AcceptInboundRequest(context.Background(), request)
 	if err != nil {
 		fmt.Fprintf(ui.Stdout, "Failed: %s\n", err)
 		return
 	}
 
+	if contact.Nickname != request.FromNickname {
+		fmt.Fprintf(ui.Stdout, "Accepted as '%s', because that nickname is already used.\n", contact.Nickname)
+		return
+	}
 	fmt.Fprintf(ui.Stdout, "Accepted!\n")
 }
@@ -17,6 +17,21 @@ type Config struct {
 	Identity *Identity           `protobuf:"bytes,1,opt,name=identity" json:"identity,omitempty"`
 	Contacts map[string]*Contact `protobuf:"bytes,2,rep,name=contacts" json:"contacts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Secrets  *Secrets            `protobuf:"bytes,3,opt,name=secrets" json:"secrets,omitempty"`
+	Settings *Settings           `protobuf:"bytes,4,opt,name=settings" json:"settings,omitempty"`
+	// Inbound contact requests that are pending or were rejected, by address
+	InboundRequests map[string]*ContactRequest `protobuf:"bytes,5,rep,name=inboundRequests" json:"inboundRequests,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Blocked         map[string]*BlockedContact `protobuf:"bytes,6,rep,name=blocked" json:"blocked,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Invites that can still be used, by token
+	Invites map[string]*Invite `protobuf:"bytes,7,rep,name=invites" json:"invites,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Recent connections to each contact, by address
+	ContactHistory map[string]*ContactHistory `protobuf:"bytes,8,rep,name=contactHistory" json:"contactHistory,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Policy for answering new inbound contact requests automatically
+	RequestPolicy *RequestPolicy `protobuf:"bytes,9,opt,name=requestPolicy" json:"requestPolicy,omitempty"`
+	// Recent decisions made by the request policy, oldest first
+	RequestPolicyLog []*RequestPolicyDecision `protobuf:"bytes,10,rep,name=requestPolicyLog" json:"requestPolicyLog,omitempty"`
+	// Invites that contacts gave us to pass on when introducing them, by
+	// invite token
+	Introductions map[string]*Introduction `protobuf:"bytes,11,rep,name=introductions" json:"introductions,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *Config) Reset()                    { *m = Config{} }
@@ -45,6 +60,62 @@ func (m *Config) GetSecrets() *Secrets {
 	return nil
 }
 
+func (m *Config) GetSettings() *Settings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+func (m *Config) GetInboundRequests() map[string]*ContactRequest {
+	if m != nil {
+		return m.InboundRequests
+	}
+	return nil
+}
+
+func (m *Config) GetBlocked() map[string]*BlockedContact {
+	if m != nil {
+		return m.Blocked
+	}
+	return nil
+}
+
+func (m *Config) GetInvites() map[string]*Invite {
+	if m != nil {
+		return m.Invites
+	}
+	return nil
+}
+
+func (m *Config) GetContactHistory() map[string]*ContactHistory {
+	if m != nil {
+		return m.ContactHistory
+	}
+	return nil
+}
+
+func (m *Config) GetRequestPolicy() *RequestPolicy {
+	if m != nil {
+		return m.RequestPolicy
+	}
+	return nil
+}
+
+func (m *Config) GetRequestPolicyLog() []*RequestPolicyDecision {
+	if m != nil {
+		return m.RequestPolicyLog
+	}
+	return nil
+}
+
+func (m *Config) GetIntroductions() map[string]*Introduction {
+	if m != nil {
+		return m.Introductions
+	}
+	return nil
+}
+
 // Secrets are not transmitted to frontend RPC clients
 type Secrets struct {
 	ServicePrivateKey []byte `protobuf:"bytes,1,opt,name=servicePrivateKey,proto3" json:"servicePrivateKey,omitempty"`
@@ -62,28 +133,292 @@ func (m *Secrets) GetServicePrivateKey() []byte {
 	return nil
 }
 
+// Settings are user preferences for the backend. A Config without settings
+// uses the defaults, and zero values of numeric fields also use the default.
+type Settings struct {
+	// Connect to the network when the backend starts
+	AutoConnect bool `protobuf:"varint,1,opt,name=autoConnect" json:"autoConnect,omitempty"`
+	// Address of the tor control port as 'host:port' or 'unix:/path'. If
+	// empty, the TOR_CONTROL_* environment variables or 127.0.0.1:9051 are
+	// used instead.
+	TorControlAddress string `protobuf:"bytes,2,opt,name=torControlAddress" json:"torControlAddress,omitempty"`
+	// Never sent to RPC clients. When updating settings, an empty password
+	// keeps the current one. If empty, TOR_CONTROL_PASSWD is used.
+	TorControlPassword string `protobuf:"bytes,3,opt,name=torControlPassword" json:"torControlPassword,omitempty"`
+	// Maximum number of messages kept in memory for each conversation
+	ConversationBacklog uint32 `protobuf:"varint,4,opt,name=conversationBacklog" json:"conversationBacklog,omitempty"`
+	// Seconds to wait for the contents of an inbound contact request
+	RequestTimeout uint32 `protobuf:"varint,5,opt,name=requestTimeout" json:"requestTimeout,omitempty"`
+	// Seconds to wait between attempts to connect to a contact; the last
+	// delay is repeated for all further attempts.
+	ReconnectDelays []uint32 `protobuf:"varint,6,rep,packed,name=reconnectDelays" json:"reconnectDelays,omitempty"`
+	// Days to keep inbound contact requests that haven't been accepted.
+	// Rejected requests are kept for this long after they were rejected, and
+	// new requests from the same address are rejected automatically.
+	RequestExpiry uint32 `protobuf:"varint,7,opt,name=requestExpiry" json:"requestExpiry,omitempty"`
+	// Days to keep trying to deliver an outbound contact request before it
+	// expires. An expired request can be retried.
+	OutboundRequestExpiry uint32 `protobuf:"varint,8,opt,name=outboundRequestExpiry" json:"outboundRequestExpiry,omitempty"`
+	// Limits on inbound contact requests, which protect against a peer using
+	// many addresses to flood the client. Maximum number of connections
+	// waiting for a request or a user response:
+	MaxRequestConnections uint32 `protobuf:"varint,9,opt,name=maxRequestConnections" json:"maxRequestConnections,omitempty"`
+	// Maximum number of requests waiting for a user response
+	MaxPendingRequests uint32 `protobuf:"varint,10,opt,name=maxPendingRequests" json:"maxPendingRequests,omitempty"`
+	// Minutes that requests from an address are rejected after its request
+	// was rejected
+	RequestCooldown uint32 `protobuf:"varint,11,opt,name=requestCooldown" json:"requestCooldown,omitempty"`
+	// Maximum number of new requests within requestRateWindow minutes
+	RequestRateLimit  uint32 `protobuf:"varint,12,opt,name=requestRateLimit" json:"requestRateLimit,omitempty"`
+	RequestRateWindow uint32 `protobuf:"varint,13,opt,name=requestRateWindow" json:"requestRateWindow,omitempty"`
+}
+
+func (m *Settings) Reset()                    { *m = Settings{} }
+func (m *Settings) String() string            { return proto.CompactTextString(m) }
+func (*Settings) ProtoMessage()               {}
+func (*Settings) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{2} }
+
+func (m *Settings) GetAutoConnect() bool {
+	if m != nil {
+		return m.AutoConnect
+	}
+	return false
+}
+
+func (m *Settings) GetTorControlAddress() string {
+	if m != nil {
+		return m.TorControlAddress
+	}
+	return ""
+}
+
+func (m *Settings) GetTorControlPassword() string {
+	if m != nil {
+		return m.TorControlPassword
+	}
+	return ""
+}
+
+func (m *Settings) GetConversationBacklog() uint32 {
+	if m != nil {
+		return m.ConversationBacklog
+	}
+	return 0
+}
+
+func (m *Settings) GetRequestTimeout() uint32 {
+	if m != nil {
+		return m.RequestTimeout
+	}
+	return 0
+}
+
+func (m *Settings) GetReconnectDelays() []uint32 {
+	if m != nil {
+		return m.ReconnectDelays
+	}
+	return nil
+}
+
+func (m *Settings) GetRequestExpiry() uint32 {
+	if m != nil {
+		return m.RequestExpiry
+	}
+	return 0
+}
+
+func (m *Settings) GetOutboundRequestExpiry() uint32 {
+	if m != nil {
+		return m.OutboundRequestExpiry
+	}
+	return 0
+}
+
+func (m *Settings) GetMaxRequestConnections() uint32 {
+	if m != nil {
+		return m.MaxRequestConnections
+	}
+	return 0
+}
+
+func (m *Settings) GetMaxPendingRequests() uint32 {
+	if m != nil {
+		return m.MaxPendingRequests
+	}
+	return 0
+}
+
+func (m *Settings) GetRequestCooldown() uint32 {
+	if m != nil {
+		return m.RequestCooldown
+	}
+	return 0
+}
+
+func (m *Settings) GetRequestRateLimit() uint32 {
+	if m != nil {
+		return m.RequestRateLimit
+	}
+	return 0
+}
+
+func (m *Settings) GetRequestRateWindow() uint32 {
+	if m != nil {
+		return m.RequestRateWindow
+	}
+	return 0
+}
+
+// ConfigStatus describes the health of the persistent configuration
+type ConfigStatus struct {
+	// Error from the most recent attempt to save the configuration. If set,
+	// changes since lastSaved only exist in memory.
+	SaveError string `protobuf:"bytes,1,opt,name=saveError" json:"saveError,omitempty"`
+	LastSaved string `protobuf:"bytes,2,opt,name=lastSaved" json:"lastSaved,omitempty"`
+	// Set when the configuration was damaged or missing at startup and
+	// was recovered from this backup file.
+	RecoveredFrom string `protobuf:"bytes,3,opt,name=recoveredFrom" json:"recoveredFrom,omitempty"`
+}
+
+func (m *ConfigStatus) Reset()                    { *m = ConfigStatus{} }
+func (m *ConfigStatus) String() string            { return proto.CompactTextString(m) }
+func (*ConfigStatus) ProtoMessage()               {}
+func (*ConfigStatus) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{3} }
+
+func (m *ConfigStatus) GetSaveError() string {
+	if m != nil {
+		return m.SaveError
+	}
+	return ""
+}
+
+func (m *ConfigStatus) GetLastSaved() string {
+	if m != nil {
+		return m.LastSaved
+	}
+	return ""
+}
+
+func (m *ConfigStatus) GetRecoveredFrom() string {
+	if m != nil {
+		return m.RecoveredFrom
+	}
+	return ""
+}
+
+type MonitorConfigRequest struct {
+}
+
+func (m *MonitorConfigRequest) Reset()                    { *m = MonitorConfigRequest{} }
+func (m *MonitorConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*MonitorConfigRequest) ProtoMessage()               {}
+func (*MonitorConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{4} }
+
+type ReloadConfigRequest struct {
+}
+
+func (m *ReloadConfigRequest) Reset()                    { *m = ReloadConfigRequest{} }
+func (m *ReloadConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReloadConfigRequest) ProtoMessage()               {}
+func (*ReloadConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{5} }
+
+type GetSettingsRequest struct {
+}
+
+func (m *GetSettingsRequest) Reset()                    { *m = GetSettingsRequest{} }
+func (m *GetSettingsRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetSettingsRequest) ProtoMessage()               {}
+func (*GetSettingsRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{6} }
+
+type UpdateSettingsRequest struct {
+	Settings                *Settings `protobuf:"bytes,1,opt,name=settings" json:"settings,omitempty"`
+	ClearTorControlPassword bool      `protobuf:"varint,2,opt,name=clearTorControlPassword" json:"clearTorControlPassword,omitempty"`
+}
+
+func (m *UpdateSettingsRequest) Reset()                    { *m = UpdateSettingsRequest{} }
+func (m *UpdateSettingsRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateSettingsRequest) ProtoMessage()               {}
+func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) { return fileDescriptor5, []int{7} }
+
+func (m *UpdateSettingsRequest) GetSettings() *Settings {
+	if m != nil {
+		return m.Settings
+	}
+	return nil
+}
+
+func (m *UpdateSettingsRequest) GetClearTorControlPassword() bool {
+	if m != nil {
+		return m.ClearTorControlPassword
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Config)(nil), "ricochet.Config")
 	proto.RegisterType((*Secrets)(nil), "ricochet.Secrets")
+	proto.RegisterType((*Settings)(nil), "ricochet.Settings")
+	proto.RegisterType((*ConfigStatus)(nil), "ricochet.ConfigStatus")
+	proto.RegisterType((*MonitorConfigRequest)(nil), "ricochet.MonitorConfigRequest")
+	proto.RegisterType((*ReloadConfigRequest)(nil), "ricochet.ReloadConfigRequest")
+	proto.RegisterType((*GetSettingsRequest)(nil), "ricochet.GetSettingsRequest")
+	proto.RegisterType((*UpdateSettingsRequest)(nil), "ricochet.UpdateSettingsRequest")
 }
 
 func init() { proto.RegisterFile("config.proto", fileDescriptor5) }
 
 var fileDescriptor5 = []byte{
-	// 235 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0xce, 0xcf, 0x4b,
-	0xcb, 0x4c, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x28, 0xca, 0x4c, 0xce, 0x4f, 0xce,
-	0x48, 0x2d, 0x91, 0xe2, 0x4d, 0xce, 0xcf, 0x2b, 0x49, 0x4c, 0x2e, 0x81, 0x48, 0x48, 0xf1, 0x65,
-	0xa6, 0xa4, 0xe6, 0x95, 0x64, 0x96, 0x54, 0x42, 0xf8, 0x4a, 0x1f, 0x19, 0xb9, 0xd8, 0x9c, 0xc1,
-	0x3a, 0x85, 0xf4, 0xb8, 0x38, 0x60, 0x92, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x42, 0x7a,
-	0x30, 0x63, 0xf4, 0x3c, 0xa1, 0x32, 0x41, 0x70, 0x35, 0x42, 0x56, 0x5c, 0x1c, 0x50, 0xb3, 0x8b,
-	0x25, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0xe4, 0x10, 0xea, 0x21, 0x66, 0x82, 0x28, 0xb0, 0x02,
-	0xd7, 0xbc, 0x92, 0xa2, 0xca, 0x20, 0xb8, 0x7a, 0x21, 0x6d, 0x2e, 0xf6, 0xe2, 0xd4, 0xe4, 0xa2,
-	0xd4, 0x92, 0x62, 0x09, 0x66, 0xb0, 0x55, 0x82, 0x08, 0xad, 0xc1, 0x10, 0x89, 0x20, 0x98, 0x0a,
-	0x29, 0x3f, 0x2e, 0x5e, 0x14, 0x73, 0x84, 0x04, 0xb8, 0x98, 0xb3, 0x53, 0x21, 0x8e, 0xe4, 0x0c,
-	0x02, 0x31, 0x85, 0xd4, 0xb9, 0x58, 0xcb, 0x12, 0x73, 0x4a, 0x53, 0x25, 0x98, 0xd0, 0x4d, 0x83,
-	0xea, 0x0c, 0x82, 0xc8, 0x5b, 0x31, 0x59, 0x30, 0x2a, 0x99, 0x73, 0xb1, 0x43, 0xed, 0x10, 0xd2,
-	0xe1, 0x12, 0x2c, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0x0d, 0x28, 0xca, 0x2c, 0x4b, 0x2c, 0x49,
-	0xf5, 0x86, 0x9a, 0xcb, 0x13, 0x84, 0x29, 0x91, 0xc4, 0x06, 0x0e, 0x33, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x42, 0xba, 0x23, 0x37, 0x6c, 0x01, 0x00, 0x00,
+	// 822 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x5f, 0x6f, 0x5b, 0x35,
+	0x14, 0x57, 0xd2, 0xb5, 0x49, 0x4e, 0x92, 0xae, 0x73, 0xdb, 0xcd, 0x8a, 0xf8, 0x53, 0x85, 0x31,
+	0x2a, 0x98, 0x22, 0x34, 0x90, 0x36, 0x4d, 0xe2, 0x81, 0x75, 0x05, 0xa2, 0x05, 0x88, 0x9c, 0x22,
+	0x90, 0xe0, 0xc5, 0xf5, 0x75, 0x83, 0xd5, 0x1b, 0x3b, 0xd8, 0xbe, 0xb7, 0xcd, 0x23, 0x5f, 0x8d,
+	0xcf, 0xc0, 0x07, 0x42, 0xd7, 0x76, 0x92, 0xfb, 0x2f, 0x12, 0xf0, 0x96, 0xfc, 0xfe, 0xe5, 0xf8,
+	0xdc, 0xe3, 0x73, 0x03, 0x3d, 0xa6, 0xe4, 0x8d, 0x98, 0x8f, 0x96, 0x5a, 0x59, 0x85, 0xda, 0x5a,
+	0x30, 0xc5, 0x7e, 0xe7, 0x76, 0xd0, 0x67, 0x4a, 0x5a, 0xca, 0xac, 0x27, 0x06, 0x87, 0x22, 0xe2,
+	0xd2, 0x0a, 0xbb, 0xf2, 0xdf, 0x87, 0x7f, 0x75, 0xe0, 0xe0, 0xc2, 0x39, 0xd1, 0x08, 0xda, 0x6b,
+	0x12, 0x37, 0xce, 0x1a, 0xe7, 0xdd, 0x17, 0x68, 0xb4, 0x8e, 0x19, 0x8d, 0x03, 0x43, 0x36, 0x1a,
+	0xf4, 0x1a, 0xda, 0x21, 0xdb, 0xe0, 0xe6, 0xd9, 0xde, 0x79, 0xf7, 0xc5, 0x07, 0x5b, 0xbd, 0xcf,
+	0x1c, 0x5d, 0x04, 0xc1, 0xa5, 0xb4, 0x7a, 0x45, 0x36, 0x7a, 0xf4, 0x19, 0xb4, 0x0c, 0x67, 0x9a,
+	0x5b, 0x83, 0xf7, 0xdc, 0x4f, 0x3d, 0xda, 0x5a, 0x67, 0x9e, 0x20, 0x6b, 0x45, 0x56, 0x98, 0xe1,
+	0xd6, 0x0a, 0x39, 0x37, 0xf8, 0x41, 0xb9, 0xb0, 0x59, 0x60, 0xc8, 0x46, 0x83, 0x7e, 0x84, 0x87,
+	0x42, 0x5e, 0xab, 0x44, 0x46, 0x84, 0xff, 0x91, 0x70, 0x63, 0x0d, 0xde, 0x77, 0xf5, 0x7d, 0x5c,
+	0xa9, 0x6f, 0x5c, 0xd4, 0xf9, 0x32, 0xcb, 0x6e, 0xf4, 0x12, 0x5a, 0xd7, 0xb1, 0x62, 0xb7, 0x3c,
+	0xc2, 0x07, 0x2e, 0xe8, 0xfd, 0x4a, 0xd0, 0x1b, 0xcf, 0xfb, 0x80, 0xb5, 0x3a, 0x33, 0x0a, 0x99,
+	0x0a, 0xcb, 0x0d, 0x6e, 0xed, 0x30, 0x8e, 0x3d, 0x1f, 0x8c, 0x41, 0x8d, 0x26, 0x70, 0x18, 0x7a,
+	0xf5, 0x9d, 0x30, 0x56, 0xe9, 0x15, 0x6e, 0x3b, 0xff, 0xd3, 0x5d, 0x1d, 0x0e, 0x32, 0x1f, 0x53,
+	0xf2, 0xa2, 0xaf, 0xa0, 0xaf, 0xfd, 0x59, 0xa6, 0x2a, 0x16, 0x6c, 0x85, 0x3b, 0xae, 0x8b, 0x4f,
+	0xb6, 0x61, 0x24, 0x4f, 0x93, 0xa2, 0x1a, 0xbd, 0x83, 0xa3, 0x02, 0x30, 0x51, 0x73, 0x0c, 0xae,
+	0x9c, 0x0f, 0x77, 0x24, 0xbc, 0xe5, 0x4c, 0x18, 0xa1, 0x24, 0xa9, 0x18, 0xd1, 0x18, 0xfa, 0x42,
+	0x5a, 0xad, 0xa2, 0x84, 0x59, 0xa1, 0xa4, 0xc1, 0x5d, 0x97, 0xf4, 0x51, 0x4d, 0x63, 0x72, 0x2a,
+	0x7f, 0xae, 0xa2, 0x73, 0xf0, 0x03, 0xf4, 0x0b, 0xf3, 0x85, 0x8e, 0x60, 0xef, 0x96, 0xfb, 0xe1,
+	0xed, 0x90, 0xec, 0x23, 0xfa, 0x04, 0xf6, 0x53, 0x1a, 0x27, 0x1c, 0x37, 0xcb, 0x53, 0x16, 0x9c,
+	0xc4, 0xf3, 0xaf, 0x9b, 0xaf, 0x1a, 0x83, 0xdf, 0xe0, 0xa4, 0x6e, 0x1e, 0x6a, 0x62, 0x47, 0xc5,
+	0x58, 0x5c, 0x8d, 0xf5, 0x01, 0xf9, 0xf4, 0x2b, 0xe8, 0xe5, 0x87, 0xe4, 0x3f, 0xa5, 0x06, 0x63,
+	0x4d, 0xcd, 0x13, 0xe8, 0xe5, 0x27, 0xa8, 0x26, 0xf5, 0x59, 0x31, 0xf5, 0x28, 0x77, 0xa7, 0x9d,
+	0x31, 0x9f, 0xf6, 0x2b, 0x1c, 0xd7, 0xcc, 0xd3, 0xff, 0x69, 0x40, 0xf0, 0xe7, 0xc3, 0x7f, 0x01,
+	0x54, 0x7d, 0xa6, 0x35, 0xd9, 0xcf, 0x8b, 0xd9, 0x8f, 0xf3, 0x05, 0x6f, 0xed, 0xb9, 0xe4, 0xe1,
+	0x4b, 0x68, 0x85, 0xa5, 0x81, 0x9e, 0xc3, 0x23, 0xc3, 0x75, 0x2a, 0x18, 0x9f, 0x6a, 0x91, 0x52,
+	0xcb, 0xdf, 0x85, 0xf0, 0x1e, 0xa9, 0x12, 0xc3, 0xbf, 0x1f, 0x40, 0x7b, 0xbd, 0x40, 0xd0, 0x19,
+	0x74, 0x69, 0x62, 0xd5, 0x85, 0x92, 0x92, 0x33, 0xeb, 0x4c, 0x6d, 0x92, 0x87, 0xb2, 0x70, 0xab,
+	0x74, 0x76, 0x42, 0xad, 0xe2, 0xaf, 0xa3, 0x48, 0x73, 0x63, 0x5c, 0x95, 0x1d, 0x52, 0x25, 0xd0,
+	0x08, 0xd0, 0x16, 0x9c, 0x52, 0x63, 0xee, 0x94, 0x8e, 0xdc, 0xba, 0xeb, 0x90, 0x1a, 0x06, 0x7d,
+	0x0e, 0xc7, 0x4c, 0xc9, 0x94, 0x6b, 0x43, 0xb3, 0x03, 0xbe, 0xa1, 0xec, 0x36, 0x56, 0x73, 0xb7,
+	0xf1, 0xfa, 0xa4, 0x8e, 0x42, 0xcf, 0xe0, 0x30, 0xdc, 0xaf, 0x2b, 0xb1, 0xe0, 0x2a, 0xb1, 0x78,
+	0xdf, 0x89, 0x4b, 0x28, 0x3a, 0x87, 0x87, 0x9a, 0x33, 0x7f, 0x88, 0xb7, 0x3c, 0xa6, 0x2b, 0xe3,
+	0xf6, 0x58, 0x9f, 0x94, 0x61, 0xf4, 0x74, 0xb3, 0x29, 0x2e, 0xef, 0x97, 0x42, 0xaf, 0x70, 0xcb,
+	0x05, 0x16, 0x41, 0xf4, 0x25, 0x9c, 0xaa, 0xc4, 0xe6, 0x6f, 0x4a, 0x50, 0xb7, 0x9d, 0xba, 0x9e,
+	0xcc, 0x5c, 0x0b, 0x7a, 0x1f, 0xb0, 0xd0, 0x52, 0xb7, 0x01, 0x3a, 0xde, 0x55, 0x4b, 0x66, 0x5d,
+	0x5c, 0xd0, 0xfb, 0x29, 0x97, 0x91, 0x90, 0xf3, 0xcd, 0x3e, 0x07, 0x67, 0xa9, 0x61, 0xfc, 0x59,
+	0x43, 0x8a, 0x8a, 0x23, 0x75, 0x27, 0x71, 0xd7, 0x89, 0xcb, 0x30, 0xfa, 0x74, 0xb3, 0xd6, 0x08,
+	0xb5, 0x7c, 0x22, 0x16, 0xc2, 0xe2, 0x9e, 0x93, 0x56, 0xf0, 0xec, 0xc9, 0xe7, 0xb0, 0x9f, 0x85,
+	0x8c, 0xd4, 0x1d, 0xee, 0x3b, 0x71, 0x95, 0x18, 0x2e, 0xa1, 0xe7, 0x97, 0xd8, 0xcc, 0x52, 0x9b,
+	0x18, 0xf4, 0x1e, 0x74, 0x0c, 0x4d, 0xf9, 0xa5, 0xd6, 0x4a, 0x87, 0x49, 0xdf, 0x02, 0x19, 0x1b,
+	0x53, 0x63, 0x67, 0x34, 0xe5, 0x51, 0x98, 0xa6, 0x2d, 0xe0, 0x9f, 0x08, 0x53, 0x29, 0xd7, 0x3c,
+	0xfa, 0x46, 0xab, 0x45, 0x18, 0xa0, 0x22, 0x38, 0x7c, 0x0c, 0x27, 0xdf, 0x2b, 0x29, 0xfc, 0x54,
+	0xdd, 0x88, 0x75, 0x3b, 0x86, 0xa7, 0x70, 0x4c, 0x78, 0xac, 0x68, 0x54, 0x84, 0x4f, 0x00, 0x7d,
+	0xcb, 0xed, 0xe6, 0xd5, 0x19, 0xd0, 0x3f, 0x1b, 0x70, 0xfa, 0xd3, 0x32, 0xa2, 0x96, 0x97, 0x98,
+	0xc2, 0x1b, 0xb8, 0xf1, 0x2f, 0xde, 0xc0, 0xaf, 0xe0, 0x09, 0x8b, 0x39, 0xd5, 0x57, 0xd5, 0xf9,
+	0x6f, 0xba, 0x6b, 0xb5, 0x8b, 0xbe, 0x3e, 0x70, 0x7f, 0x4b, 0xbe, 0xf8, 0x67, 0x00, 0xa8, 0x0d,
+	0x54, 0xba, 0xcf, 0x08, 0x00, 0x00,
 }
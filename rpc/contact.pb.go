@@ -15,13 +15,52 @@ It is generated from these files:
 
 It has these top-level messages:
 	Contact
+	MetadataValue
 	ContactRequest
+	BlockedContact
+	ContactHistory
+	ConnectionRecord
+	ContactHistoryRequest
 	MonitorContactsRequest
 	ContactEvent
 	AddContactReply
+	UpdateContactRequest
 	DeleteContactRequest
 	DeleteContactReply
 	RejectInboundRequestReply
+	WithdrawOutboundRequestReply
+	BlockContactRequest
+	UnblockContactRequest
+	UnblockContactReply
+	ListBlockedContactsRequest
+	ListBlockedContactsReply
+	InboundRequestStatsRequest
+	InboundRequestStats
+	Invite
+	CreateInviteRequest
+	ListInvitesRequest
+	ListInvitesReply
+	RevokeInviteRequest
+	RevokeInviteReply
+	RequestPolicy
+	RequestPolicyDecision
+	GetRequestPolicyRequest
+	SetRequestPolicyRequest
+	ListRequestPolicyDecisionsRequest
+	ListRequestPolicyDecisionsReply
+	ContactRecord
+	ExportContactsRequest
+	ExportContactsReply
+	ImportContactsRequest
+	ImportContactResult
+	Introduction
+	IntroduceContactRequest
+	IntroduceContactReply
+	ContactURI
+	ParseContactURIRequest
+	VerificationCodeRequest
+	VerificationCode
+	MarkVerifiedRequest
 	ConversationEvent
 	MonitorConversationsRequest
 	Entity
@@ -32,6 +71,8 @@ It has these top-level messages:
 	ServerStatusReply
 	Identity
 	IdentityRequest
+	IdentityCardRequest
+	IdentityCard
 	MonitorNetworkRequest
 	TorProcessStatus
 	TorControlStatus
@@ -41,6 +82,12 @@ It has these top-level messages:
 	StopNetworkRequest
 	Config
 	Secrets
+	Settings
+	ConfigStatus
+	MonitorConfigRequest
+	ReloadConfigRequest
+	GetSettingsRequest
+	UpdateSettingsRequest
 */
 package ricochet
 
@@ -59,13 +106,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// ContactRecordFormat is the file format of exported contacts. JSON is an
+// array of ContactRecord objects. CSV has a header row with the columns
+// "address", "nickname", "tags", "notes", and "message", and tags are
+// separated by spaces.
+type ContactRecordFormat int32
+
+const (
+	ContactRecordFormat_JSON ContactRecordFormat = 0
+	ContactRecordFormat_CSV  ContactRecordFormat = 1
+)
+
+var ContactRecordFormat_name = map[int32]string{
+	0: "JSON",
+	1: "CSV",
+}
+var ContactRecordFormat_value = map[string]int32{
+	"JSON": 0,
+	"CSV":  1,
+}
+
+func (x ContactRecordFormat) String() string {
+	return proto.EnumName(ContactRecordFormat_name, int32(x))
+}
+func (ContactRecordFormat) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
 type Contact_Status int32
 
 const (
-	Contact_UNKNOWN  Contact_Status = 0
-	Contact_OFFLINE  Contact_Status = 1
-	Contact_ONLINE   Contact_Status = 2
-	Contact_REQUEST  Contact_Status = 3
+	Contact_UNKNOWN Contact_Status = 0
+	Contact_OFFLINE Contact_Status = 1
+	Contact_ONLINE  Contact_Status = 2
+	Contact_REQUEST Contact_Status = 3
+	// The outbound request was rejected, or without a request, the
+	// contact has removed us. No connections are attempted until a
+	// request is sent again.
 	Contact_REJECTED Contact_Status = 4
 )
 
@@ -108,7 +183,7 @@ var ContactRequest_Direction_value = map[string]int32{
 func (x ContactRequest_Direction) String() string {
 	return proto.EnumName(ContactRequest_Direction_name, int32(x))
 }
-func (ContactRequest_Direction) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{1, 0} }
+func (ContactRequest_Direction) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{2, 0} }
 
 type ContactEvent_Type int32
 
@@ -138,7 +213,90 @@ var ContactEvent_Type_value = map[string]int32{
 func (x ContactEvent_Type) String() string {
 	return proto.EnumName(ContactEvent_Type_name, int32(x))
 }
-func (ContactEvent_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{3, 0} }
+func (ContactEvent_Type) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{8, 0} }
+
+type RequestPolicy_Mode int32
+
+const (
+	// Queue every request for the user to review
+	RequestPolicy_MANUAL     RequestPolicy_Mode = 0
+	RequestPolicy_ACCEPT_ALL RequestPolicy_Mode = 1
+	RequestPolicy_REJECT_ALL RequestPolicy_Mode = 2
+	// Accept requests with a message that matches messagePattern or
+	// contains messageToken, or from an address in allowedAddresses
+	RequestPolicy_ACCEPT_MATCHING RequestPolicy_Mode = 3
+)
+
+var RequestPolicy_Mode_name = map[int32]string{
+	0: "MANUAL",
+	1: "ACCEPT_ALL",
+	2: "REJECT_ALL",
+	3: "ACCEPT_MATCHING",
+}
+var RequestPolicy_Mode_value = map[string]int32{
+	"MANUAL":          0,
+	"ACCEPT_ALL":      1,
+	"REJECT_ALL":      2,
+	"ACCEPT_MATCHING": 3,
+}
+
+func (x RequestPolicy_Mode) String() string {
+	return proto.EnumName(RequestPolicy_Mode_name, int32(x))
+}
+func (RequestPolicy_Mode) EnumDescriptor() ([]byte, []int) { return fileDescriptor0, []int{28, 0} }
+
+type RequestPolicyDecision_Action int32
+
+const (
+	RequestPolicyDecision_QUEUED   RequestPolicyDecision_Action = 0
+	RequestPolicyDecision_ACCEPTED RequestPolicyDecision_Action = 1
+	RequestPolicyDecision_REJECTED RequestPolicyDecision_Action = 2
+)
+
+var RequestPolicyDecision_Action_name = map[int32]string{
+	0: "QUEUED",
+	1: "ACCEPTED",
+	2: "REJECTED",
+}
+var RequestPolicyDecision_Action_value = map[string]int32{
+	"QUEUED":   0,
+	"ACCEPTED": 1,
+	"REJECTED": 2,
+}
+
+func (x RequestPolicyDecision_Action) String() string {
+	return proto.EnumName(RequestPolicyDecision_Action_name, int32(x))
+}
+func (RequestPolicyDecision_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{29, 0}
+}
+
+type ImportContactResult_Result int32
+
+const (
+	ImportContactResult_ADDED ImportContactResult_Result = 0
+	// A contact with the address already exists
+	ImportContactResult_SKIPPED ImportContactResult_Result = 1
+	ImportContactResult_FAILED  ImportContactResult_Result = 2
+)
+
+var ImportContactResult_Result_name = map[int32]string{
+	0: "ADDED",
+	1: "SKIPPED",
+	2: "FAILED",
+}
+var ImportContactResult_Result_value = map[string]int32{
+	"ADDED":   0,
+	"SKIPPED": 1,
+	"FAILED":  2,
+}
+
+func (x ImportContactResult_Result) String() string {
+	return proto.EnumName(ImportContactResult_Result_name, int32(x))
+}
+func (ImportContactResult_Result) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{38, 0}
+}
 
 type Contact struct {
 	Address       string          `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
@@ -147,6 +305,20 @@ type Contact struct {
 	LastConnected string          `protobuf:"bytes,5,opt,name=lastConnected" json:"lastConnected,omitempty"`
 	Request       *ContactRequest `protobuf:"bytes,6,opt,name=request" json:"request,omitempty"`
 	Status        Contact_Status  `protobuf:"varint,10,opt,name=status,enum=ricochet.Contact_Status" json:"status,omitempty"`
+	// Set when the user has confirmed the verification code for this
+	// contact out of band
+	Verified     bool   `protobuf:"varint,11,opt,name=verified" json:"verified,omitempty"`
+	WhenVerified string `protobuf:"bytes,12,opt,name=whenVerified" json:"whenVerified,omitempty"`
+	// User-defined tags for organizing contacts, such as "work". Tags are
+	// lowercase and sorted, and can be used to select groups of contacts.
+	Tags []string `protobuf:"bytes,13,rep,name=tags" json:"tags,omitempty"`
+	// Private notes and metadata fields, such as "team" or "pgp", which are
+	// only seen by the user.
+	Notes    string                    `protobuf:"bytes,14,opt,name=notes" json:"notes,omitempty"`
+	Metadata map[string]*MetadataValue `protobuf:"bytes,15,rep,name=metadata" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// While hidden, the backend doesn't connect to the contact or accept
+	// their connections, and messages to them are queued.
+	Hidden bool `protobuf:"varint,16,opt,name=hidden" json:"hidden,omitempty"`
 }
 
 func (m *Contact) Reset()                    { *m = Contact{} }
@@ -196,6 +368,190 @@ func (m *Contact) GetStatus() Contact_Status {
 	return Contact_UNKNOWN
 }
 
+func (m *Contact) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *Contact) GetWhenVerified() string {
+	if m != nil {
+		return m.WhenVerified
+	}
+	return ""
+}
+
+func (m *Contact) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Contact) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *Contact) GetMetadata() map[string]*MetadataValue {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Contact) GetHidden() bool {
+	if m != nil {
+		return m.Hidden
+	}
+	return false
+}
+
+type MetadataValue struct {
+	// Types that are valid to be assigned to Value:
+	//	*MetadataValue_Text
+	//	*MetadataValue_Number
+	//	*MetadataValue_Flag
+	Value isMetadataValue_Value `protobuf_oneof:"value"`
+}
+
+func (m *MetadataValue) Reset()                    { *m = MetadataValue{} }
+func (m *MetadataValue) String() string            { return proto.CompactTextString(m) }
+func (*MetadataValue) ProtoMessage()               {}
+func (*MetadataValue) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+type isMetadataValue_Value interface {
+	isMetadataValue_Value()
+}
+
+type MetadataValue_Text struct {
+	Text string `protobuf:"bytes,1,opt,name=text,oneof"`
+}
+type MetadataValue_Number struct {
+	Number int64 `protobuf:"varint,2,opt,name=number,oneof"`
+}
+type MetadataValue_Flag struct {
+	Flag bool `protobuf:"varint,3,opt,name=flag,oneof"`
+}
+
+func (*MetadataValue_Text) isMetadataValue_Value()   {}
+func (*MetadataValue_Number) isMetadataValue_Value() {}
+func (*MetadataValue_Flag) isMetadataValue_Value()   {}
+
+func (m *MetadataValue) GetValue() isMetadataValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *MetadataValue) GetText() string {
+	if x, ok := m.GetValue().(*MetadataValue_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (m *MetadataValue) GetNumber() int64 {
+	if x, ok := m.GetValue().(*MetadataValue_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+func (m *MetadataValue) GetFlag() bool {
+	if x, ok := m.GetValue().(*MetadataValue_Flag); ok {
+		return x.Flag
+	}
+	return false
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*MetadataValue) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _MetadataValue_OneofMarshaler, _MetadataValue_OneofUnmarshaler, _MetadataValue_OneofSizer, []interface{}{
+		(*MetadataValue_Text)(nil),
+		(*MetadataValue_Number)(nil),
+		(*MetadataValue_Flag)(nil),
+	}
+}
+
+func _MetadataValue_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*MetadataValue)
+	// value
+	switch x := m.Value.(type) {
+	case *MetadataValue_Text:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		b.EncodeStringBytes(x.Text)
+	case *MetadataValue_Number:
+		b.EncodeVarint(2<<3 | proto.WireVarint)
+		b.EncodeVarint(uint64(x.Number))
+	case *MetadataValue_Flag:
+		t := uint64(0)
+		if x.Flag {
+			t = 1
+		}
+		b.EncodeVarint(3<<3 | proto.WireVarint)
+		b.EncodeVarint(t)
+	case nil:
+	default:
+		return fmt.Errorf("MetadataValue.Value has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _MetadataValue_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*MetadataValue)
+	switch tag {
+	case 1: // value.text
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeStringBytes()
+		m.Value = &MetadataValue_Text{x}
+		return true, err
+	case 2: // value.number
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Value = &MetadataValue_Number{int64(x)}
+		return true, err
+	case 3: // value.flag
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Value = &MetadataValue_Flag{x != 0}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _MetadataValue_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*MetadataValue)
+	// value
+	switch x := m.Value.(type) {
+	case *MetadataValue_Text:
+		n += proto.SizeVarint(1<<3 | proto.WireBytes)
+		n += proto.SizeVarint(uint64(len(x.Text)))
+		n += len(x.Text)
+	case *MetadataValue_Number:
+		n += proto.SizeVarint(2<<3 | proto.WireVarint)
+		n += proto.SizeVarint(uint64(x.Number))
+	case *MetadataValue_Flag:
+		n += proto.SizeVarint(3<<3 | proto.WireVarint)
+		n += 1
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
 type ContactRequest struct {
 	Direction     ContactRequest_Direction `protobuf:"varint,1,opt,name=direction,enum=ricochet.ContactRequest_Direction" json:"direction,omitempty"`
 	Address       string                   `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
@@ -207,12 +563,24 @@ type ContactRequest struct {
 	WhenDelivered string                   `protobuf:"bytes,8,opt,name=whenDelivered" json:"whenDelivered,omitempty"`
 	WhenRejected  string                   `protobuf:"bytes,9,opt,name=whenRejected" json:"whenRejected,omitempty"`
 	RemoteError   string                   `protobuf:"bytes,10,opt,name=remoteError" json:"remoteError,omitempty"`
+	// For outbound requests, rejected is also set when the peer responded
+	// with an error (in remoteError) or the request expired before it was
+	// accepted. Outbound requests are not sent while rejected; they can be
+	// retried after a cooldown.
+	Expired bool `protobuf:"varint,11,opt,name=expired" json:"expired,omitempty"`
+	// For inbound requests, the address of an existing contact with a
+	// nickname that looks like fromNickname. The request may be from
+	// someone impersonating that contact.
+	SimilarContact string `protobuf:"bytes,12,opt,name=similarContact" json:"similarContact,omitempty"`
+	// For outbound requests, when the request was last retried. Expiry is
+	// measured from this instead of whenCreated once it's set.
+	WhenRetried string `protobuf:"bytes,13,opt,name=whenRetried" json:"whenRetried,omitempty"`
 }
 
 func (m *ContactRequest) Reset()                    { *m = ContactRequest{} }
 func (m *ContactRequest) String() string            { return proto.CompactTextString(m) }
 func (*ContactRequest) ProtoMessage()               {}
-func (*ContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*ContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
 
 func (m *ContactRequest) GetDirection() ContactRequest_Direction {
 	if m != nil {
@@ -284,13 +652,152 @@ func (m *ContactRequest) GetRemoteError() string {
 	return ""
 }
 
+func (m *ContactRequest) GetExpired() bool {
+	if m != nil {
+		return m.Expired
+	}
+	return false
+}
+
+func (m *ContactRequest) GetSimilarContact() string {
+	if m != nil {
+		return m.SimilarContact
+	}
+	return ""
+}
+
+func (m *ContactRequest) GetWhenRetried() string {
+	if m != nil {
+		return m.WhenRetried
+	}
+	return ""
+}
+
+// BlockedContact is an address that is refused during authentication and
+// is never contacted.
+type BlockedContact struct {
+	Address     string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	WhenBlocked string `protobuf:"bytes,2,opt,name=whenBlocked" json:"whenBlocked,omitempty"`
+}
+
+func (m *BlockedContact) Reset()                    { *m = BlockedContact{} }
+func (m *BlockedContact) String() string            { return proto.CompactTextString(m) }
+func (*BlockedContact) ProtoMessage()               {}
+func (*BlockedContact) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *BlockedContact) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BlockedContact) GetWhenBlocked() string {
+	if m != nil {
+		return m.WhenBlocked
+	}
+	return ""
+}
+
+// ContactHistory is the most recent connections to a contact, oldest first
+type ContactHistory struct {
+	Address     string              `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Connections []*ConnectionRecord `protobuf:"bytes,2,rep,name=connections" json:"connections,omitempty"`
+}
+
+func (m *ContactHistory) Reset()                    { *m = ContactHistory{} }
+func (m *ContactHistory) String() string            { return proto.CompactTextString(m) }
+func (*ContactHistory) ProtoMessage()               {}
+func (*ContactHistory) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *ContactHistory) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContactHistory) GetConnections() []*ConnectionRecord {
+	if m != nil {
+		return m.Connections
+	}
+	return nil
+}
+
+type ConnectionRecord struct {
+	WhenConnected string `protobuf:"bytes,1,opt,name=whenConnected" json:"whenConnected,omitempty"`
+	// Empty while the connection is open
+	WhenDisconnected string `protobuf:"bytes,2,opt,name=whenDisconnected" json:"whenDisconnected,omitempty"`
+	// Seconds the connection was open
+	Duration int64 `protobuf:"varint,3,opt,name=duration" json:"duration,omitempty"`
+	Inbound  bool  `protobuf:"varint,4,opt,name=inbound" json:"inbound,omitempty"`
+	// The backend stopped while connected, so the end is not known
+	Interrupted bool `protobuf:"varint,5,opt,name=interrupted" json:"interrupted,omitempty"`
+}
+
+func (m *ConnectionRecord) Reset()                    { *m = ConnectionRecord{} }
+func (m *ConnectionRecord) String() string            { return proto.CompactTextString(m) }
+func (*ConnectionRecord) ProtoMessage()               {}
+func (*ConnectionRecord) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *ConnectionRecord) GetWhenConnected() string {
+	if m != nil {
+		return m.WhenConnected
+	}
+	return ""
+}
+
+func (m *ConnectionRecord) GetWhenDisconnected() string {
+	if m != nil {
+		return m.WhenDisconnected
+	}
+	return ""
+}
+
+func (m *ConnectionRecord) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ConnectionRecord) GetInbound() bool {
+	if m != nil {
+		return m.Inbound
+	}
+	return false
+}
+
+func (m *ConnectionRecord) GetInterrupted() bool {
+	if m != nil {
+		return m.Interrupted
+	}
+	return false
+}
+
+type ContactHistoryRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *ContactHistoryRequest) Reset()                    { *m = ContactHistoryRequest{} }
+func (m *ContactHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*ContactHistoryRequest) ProtoMessage()               {}
+func (*ContactHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *ContactHistoryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 type MonitorContactsRequest struct {
 }
 
 func (m *MonitorContactsRequest) Reset()                    { *m = MonitorContactsRequest{} }
 func (m *MonitorContactsRequest) String() string            { return proto.CompactTextString(m) }
 func (*MonitorContactsRequest) ProtoMessage()               {}
-func (*MonitorContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*MonitorContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 type ContactEvent struct {
 	Type ContactEvent_Type `protobuf:"varint,1,opt,name=type,enum=ricochet.ContactEvent_Type" json:"type,omitempty"`
@@ -303,7 +810,7 @@ type ContactEvent struct {
 func (m *ContactEvent) Reset()                    { *m = ContactEvent{} }
 func (m *ContactEvent) String() string            { return proto.CompactTextString(m) }
 func (*ContactEvent) ProtoMessage()               {}
-func (*ContactEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*ContactEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
 
 type isContactEvent_Subject interface {
 	isContactEvent_Subject()
@@ -427,7 +934,34 @@ type AddContactReply struct {
 func (m *AddContactReply) Reset()                    { *m = AddContactReply{} }
 func (m *AddContactReply) String() string            { return proto.CompactTextString(m) }
 func (*AddContactReply) ProtoMessage()               {}
-func (*AddContactReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*AddContactReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+// UpdateContactRequest changes the listed fields of the contact with the
+// same address to the values in contact. Fields are named as in JSON, e.g.
+// "nickname". Fields that are not listed are unchanged.
+type UpdateContactRequest struct {
+	Contact *Contact `protobuf:"bytes,1,opt,name=contact" json:"contact,omitempty"`
+	Fields  []string `protobuf:"bytes,2,rep,name=fields" json:"fields,omitempty"`
+}
+
+func (m *UpdateContactRequest) Reset()                    { *m = UpdateContactRequest{} }
+func (m *UpdateContactRequest) String() string            { return proto.CompactTextString(m) }
+func (*UpdateContactRequest) ProtoMessage()               {}
+func (*UpdateContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *UpdateContactRequest) GetContact() *Contact {
+	if m != nil {
+		return m.Contact
+	}
+	return nil
+}
+
+func (m *UpdateContactRequest) GetFields() []string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
 
 type DeleteContactRequest struct {
 	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
@@ -436,7 +970,7 @@ type DeleteContactRequest struct {
 func (m *DeleteContactRequest) Reset()                    { *m = DeleteContactRequest{} }
 func (m *DeleteContactRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteContactRequest) ProtoMessage()               {}
-func (*DeleteContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*DeleteContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 func (m *DeleteContactRequest) GetAddress() string {
 	if m != nil {
@@ -451,7 +985,7 @@ type DeleteContactReply struct {
 func (m *DeleteContactReply) Reset()                    { *m = DeleteContactReply{} }
 func (m *DeleteContactReply) String() string            { return proto.CompactTextString(m) }
 func (*DeleteContactReply) ProtoMessage()               {}
-func (*DeleteContactReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*DeleteContactReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 type RejectInboundRequestReply struct {
 }
@@ -459,60 +993,1123 @@ type RejectInboundRequestReply struct {
 func (m *RejectInboundRequestReply) Reset()                    { *m = RejectInboundRequestReply{} }
 func (m *RejectInboundRequestReply) String() string            { return proto.CompactTextString(m) }
 func (*RejectInboundRequestReply) ProtoMessage()               {}
-func (*RejectInboundRequestReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*RejectInboundRequestReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+type WithdrawOutboundRequestReply struct {
+}
+
+func (m *WithdrawOutboundRequestReply) Reset()                    { *m = WithdrawOutboundRequestReply{} }
+func (m *WithdrawOutboundRequestReply) String() string            { return proto.CompactTextString(m) }
+func (*WithdrawOutboundRequestReply) ProtoMessage()               {}
+func (*WithdrawOutboundRequestReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+type BlockContactRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *BlockContactRequest) Reset()                    { *m = BlockContactRequest{} }
+func (m *BlockContactRequest) String() string            { return proto.CompactTextString(m) }
+func (*BlockContactRequest) ProtoMessage()               {}
+func (*BlockContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *BlockContactRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type UnblockContactRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *UnblockContactRequest) Reset()                    { *m = UnblockContactRequest{} }
+func (m *UnblockContactRequest) String() string            { return proto.CompactTextString(m) }
+func (*UnblockContactRequest) ProtoMessage()               {}
+func (*UnblockContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *UnblockContactRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type UnblockContactReply struct {
+}
+
+func (m *UnblockContactReply) Reset()                    { *m = UnblockContactReply{} }
+func (m *UnblockContactReply) String() string            { return proto.CompactTextString(m) }
+func (*UnblockContactReply) ProtoMessage()               {}
+func (*UnblockContactReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+type ListBlockedContactsRequest struct {
+}
+
+func (m *ListBlockedContactsRequest) Reset()                    { *m = ListBlockedContactsRequest{} }
+func (m *ListBlockedContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListBlockedContactsRequest) ProtoMessage()               {}
+func (*ListBlockedContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+type ListBlockedContactsReply struct {
+	Blocked []*BlockedContact `protobuf:"bytes,1,rep,name=blocked" json:"blocked,omitempty"`
+}
+
+func (m *ListBlockedContactsReply) Reset()                    { *m = ListBlockedContactsReply{} }
+func (m *ListBlockedContactsReply) String() string            { return proto.CompactTextString(m) }
+func (*ListBlockedContactsReply) ProtoMessage()               {}
+func (*ListBlockedContactsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *ListBlockedContactsReply) GetBlocked() []*BlockedContact {
+	if m != nil {
+		return m.Blocked
+	}
+	return nil
+}
+
+type InboundRequestStatsRequest struct {
+}
+
+func (m *InboundRequestStatsRequest) Reset()                    { *m = InboundRequestStatsRequest{} }
+func (m *InboundRequestStatsRequest) String() string            { return proto.CompactTextString(m) }
+func (*InboundRequestStatsRequest) ProtoMessage()               {}
+func (*InboundRequestStatsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+// InboundRequestStats counts inbound contact requests and connections that
+// were refused by the limits which protect against request flooding. Counts
+// start from zero when the backend starts.
+type InboundRequestStats struct {
+	// Request connections currently open, and pending requests
+	OpenConnections int32 `protobuf:"varint,1,opt,name=openConnections" json:"openConnections,omitempty"`
+	PendingRequests int32 `protobuf:"varint,2,opt,name=pendingRequests" json:"pendingRequests,omitempty"`
+	// Request connections that were allowed
+	Connections uint64 `protobuf:"varint,3,opt,name=connections" json:"connections,omitempty"`
+	// Refused because too many request connections were already open
+	RejectedConnectionLimit uint64 `protobuf:"varint,4,opt,name=rejectedConnectionLimit" json:"rejectedConnectionLimit,omitempty"`
+	// Rejected because the address had a request rejected recently
+	RejectedCooldown uint64 `protobuf:"varint,5,opt,name=rejectedCooldown" json:"rejectedCooldown,omitempty"`
+	// Rejected because too many requests are already pending
+	RejectedPendingLimit uint64 `protobuf:"varint,6,opt,name=rejectedPendingLimit" json:"rejectedPendingLimit,omitempty"`
+	// Rejected because too many new requests arrived recently
+	RejectedRateLimit uint64 `protobuf:"varint,7,opt,name=rejectedRateLimit" json:"rejectedRateLimit,omitempty"`
+}
+
+func (m *InboundRequestStats) Reset()                    { *m = InboundRequestStats{} }
+func (m *InboundRequestStats) String() string            { return proto.CompactTextString(m) }
+func (*InboundRequestStats) ProtoMessage()               {}
+func (*InboundRequestStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+
+func (m *InboundRequestStats) GetOpenConnections() int32 {
+	if m != nil {
+		return m.OpenConnections
+	}
+	return 0
+}
+
+func (m *InboundRequestStats) GetPendingRequests() int32 {
+	if m != nil {
+		return m.PendingRequests
+	}
+	return 0
+}
+
+func (m *InboundRequestStats) GetConnections() uint64 {
+	if m != nil {
+		return m.Connections
+	}
+	return 0
+}
+
+func (m *InboundRequestStats) GetRejectedConnectionLimit() uint64 {
+	if m != nil {
+		return m.RejectedConnectionLimit
+	}
+	return 0
+}
+
+func (m *InboundRequestStats) GetRejectedCooldown() uint64 {
+	if m != nil {
+		return m.RejectedCooldown
+	}
+	return 0
+}
+
+func (m *InboundRequestStats) GetRejectedPendingLimit() uint64 {
+	if m != nil {
+		return m.RejectedPendingLimit
+	}
+	return 0
+}
+
+func (m *InboundRequestStats) GetRejectedRateLimit() uint64 {
+	if m != nil {
+		return m.RejectedRateLimit
+	}
+	return 0
+}
+
+// Invite allows contact requests to be accepted automatically. The link is
+// shared with someone out of band, and a request that includes the token in
+// its message is accepted without waiting for the user.
+type Invite struct {
+	Token string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+	// Nickname for contacts added by this invite. If empty, the nickname
+	// suggested in the request is used.
+	Nickname string `protobuf:"bytes,2,opt,name=nickname" json:"nickname,omitempty"`
+	// Number of requests that may be accepted; zero is unlimited
+	MaxUses     uint32 `protobuf:"varint,3,opt,name=maxUses" json:"maxUses,omitempty"`
+	Uses        uint32 `protobuf:"varint,4,opt,name=uses" json:"uses,omitempty"`
+	WhenCreated string `protobuf:"bytes,5,opt,name=whenCreated" json:"whenCreated,omitempty"`
+	// The invite is no longer valid after this time; empty is never
+	WhenExpires string `protobuf:"bytes,6,opt,name=whenExpires" json:"whenExpires,omitempty"`
+	// Link containing our address and the token, to share with the invitee
+	Link string `protobuf:"bytes,7,opt,name=link" json:"link,omitempty"`
+}
+
+func (m *Invite) Reset()                    { *m = Invite{} }
+func (m *Invite) String() string            { return proto.CompactTextString(m) }
+func (*Invite) ProtoMessage()               {}
+func (*Invite) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+
+func (m *Invite) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *Invite) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
+}
+
+func (m *Invite) GetMaxUses() uint32 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *Invite) GetUses() uint32 {
+	if m != nil {
+		return m.Uses
+	}
+	return 0
+}
+
+func (m *Invite) GetWhenCreated() string {
+	if m != nil {
+		return m.WhenCreated
+	}
+	return ""
+}
+
+func (m *Invite) GetWhenExpires() string {
+	if m != nil {
+		return m.WhenExpires
+	}
+	return ""
+}
+
+func (m *Invite) GetLink() string {
+	if m != nil {
+		return m.Link
+	}
+	return ""
+}
+
+type CreateInviteRequest struct {
+	Nickname string `protobuf:"bytes,1,opt,name=nickname" json:"nickname,omitempty"`
+	MaxUses  uint32 `protobuf:"varint,2,opt,name=maxUses" json:"maxUses,omitempty"`
+	// Seconds until the invite expires; zero never expires
+	ValidFor uint32 `protobuf:"varint,3,opt,name=validFor" json:"validFor,omitempty"`
+}
+
+func (m *CreateInviteRequest) Reset()                    { *m = CreateInviteRequest{} }
+func (m *CreateInviteRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateInviteRequest) ProtoMessage()               {}
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+
+func (m *CreateInviteRequest) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
+}
+
+func (m *CreateInviteRequest) GetMaxUses() uint32 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *CreateInviteRequest) GetValidFor() uint32 {
+	if m != nil {
+		return m.ValidFor
+	}
+	return 0
+}
+
+type ListInvitesRequest struct {
+}
+
+func (m *ListInvitesRequest) Reset()                    { *m = ListInvitesRequest{} }
+func (m *ListInvitesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListInvitesRequest) ProtoMessage()               {}
+func (*ListInvitesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+
+type ListInvitesReply struct {
+	Invites []*Invite `protobuf:"bytes,1,rep,name=invites" json:"invites,omitempty"`
+}
+
+func (m *ListInvitesReply) Reset()                    { *m = ListInvitesReply{} }
+func (m *ListInvitesReply) String() string            { return proto.CompactTextString(m) }
+func (*ListInvitesReply) ProtoMessage()               {}
+func (*ListInvitesReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *ListInvitesReply) GetInvites() []*Invite {
+	if m != nil {
+		return m.Invites
+	}
+	return nil
+}
+
+type RevokeInviteRequest struct {
+	Token string `protobuf:"bytes,1,opt,name=token" json:"token,omitempty"`
+}
+
+func (m *RevokeInviteRequest) Reset()                    { *m = RevokeInviteRequest{} }
+func (m *RevokeInviteRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevokeInviteRequest) ProtoMessage()               {}
+func (*RevokeInviteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *RevokeInviteRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type RevokeInviteReply struct {
+}
+
+func (m *RevokeInviteReply) Reset()                    { *m = RevokeInviteReply{} }
+func (m *RevokeInviteReply) String() string            { return proto.CompactTextString(m) }
+func (*RevokeInviteReply) ProtoMessage()               {}
+func (*RevokeInviteReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+// RequestPolicy decides how new inbound contact requests are answered.
+// Requests with a valid invite, or from an address that's already a contact,
+// are accepted regardless of the policy. A request that was already queued
+// for review stays queued if the policy changes.
+type RequestPolicy struct {
+	Mode RequestPolicy_Mode `protobuf:"varint,1,opt,name=mode,enum=ricochet.RequestPolicy_Mode" json:"mode,omitempty"`
+	// Regular expression (RE2 syntax) matched against the request message
+	MessagePattern string `protobuf:"bytes,2,opt,name=messagePattern" json:"messagePattern,omitempty"`
+	// Accept messages that contain this word, e.g. a shared password
+	MessageToken     string   `protobuf:"bytes,3,opt,name=messageToken" json:"messageToken,omitempty"`
+	AllowedAddresses []string `protobuf:"bytes,4,rep,name=allowedAddresses" json:"allowedAddresses,omitempty"`
+	// In ACCEPT_MATCHING mode, reject requests that don't match instead of
+	// queueing them for review
+	RejectUnmatched bool `protobuf:"varint,5,opt,name=rejectUnmatched" json:"rejectUnmatched,omitempty"`
+}
+
+func (m *RequestPolicy) Reset()                    { *m = RequestPolicy{} }
+func (m *RequestPolicy) String() string            { return proto.CompactTextString(m) }
+func (*RequestPolicy) ProtoMessage()               {}
+func (*RequestPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *RequestPolicy) GetMode() RequestPolicy_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return RequestPolicy_MANUAL
+}
+
+func (m *RequestPolicy) GetMessagePattern() string {
+	if m != nil {
+		return m.MessagePattern
+	}
+	return ""
+}
+
+func (m *RequestPolicy) GetMessageToken() string {
+	if m != nil {
+		return m.MessageToken
+	}
+	return ""
+}
+
+func (m *RequestPolicy) GetAllowedAddresses() []string {
+	if m != nil {
+		return m.AllowedAddresses
+	}
+	return nil
+}
+
+func (m *RequestPolicy) GetRejectUnmatched() bool {
+	if m != nil {
+		return m.RejectUnmatched
+	}
+	return false
+}
+
+// RequestPolicyDecision records how the request policy answered a request
+type RequestPolicyDecision struct {
+	Address  string                       `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Nickname string                       `protobuf:"bytes,2,opt,name=nickname" json:"nickname,omitempty"`
+	When     string                       `protobuf:"bytes,3,opt,name=when" json:"when,omitempty"`
+	Action   RequestPolicyDecision_Action `protobuf:"varint,4,opt,name=action,enum=ricochet.RequestPolicyDecision_Action" json:"action,omitempty"`
+	Mode     RequestPolicy_Mode           `protobuf:"varint,5,opt,name=mode,enum=ricochet.RequestPolicy_Mode" json:"mode,omitempty"`
+	// Why the decision was made, e.g. which rule matched
+	Reason string `protobuf:"bytes,6,opt,name=reason" json:"reason,omitempty"`
+	// Nickname given to the contact if it was accepted
+	ContactNickname string `protobuf:"bytes,7,opt,name=contactNickname" json:"contactNickname,omitempty"`
+}
+
+func (m *RequestPolicyDecision) Reset()                    { *m = RequestPolicyDecision{} }
+func (m *RequestPolicyDecision) String() string            { return proto.CompactTextString(m) }
+func (*RequestPolicyDecision) ProtoMessage()               {}
+func (*RequestPolicyDecision) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *RequestPolicyDecision) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RequestPolicyDecision) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
+}
+
+func (m *RequestPolicyDecision) GetWhen() string {
+	if m != nil {
+		return m.When
+	}
+	return ""
+}
+
+func (m *RequestPolicyDecision) GetAction() RequestPolicyDecision_Action {
+	if m != nil {
+		return m.Action
+	}
+	return RequestPolicyDecision_QUEUED
+}
+
+func (m *RequestPolicyDecision) GetMode() RequestPolicy_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return RequestPolicy_MANUAL
+}
+
+func (m *RequestPolicyDecision) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RequestPolicyDecision) GetContactNickname() string {
+	if m != nil {
+		return m.ContactNickname
+	}
+	return ""
+}
+
+type GetRequestPolicyRequest struct {
+}
+
+func (m *GetRequestPolicyRequest) Reset()                    { *m = GetRequestPolicyRequest{} }
+func (m *GetRequestPolicyRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRequestPolicyRequest) ProtoMessage()               {}
+func (*GetRequestPolicyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+type SetRequestPolicyRequest struct {
+	Policy *RequestPolicy `protobuf:"bytes,1,opt,name=policy" json:"policy,omitempty"`
+}
+
+func (m *SetRequestPolicyRequest) Reset()                    { *m = SetRequestPolicyRequest{} }
+func (m *SetRequestPolicyRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetRequestPolicyRequest) ProtoMessage()               {}
+func (*SetRequestPolicyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+func (m *SetRequestPolicyRequest) GetPolicy() *RequestPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type ListRequestPolicyDecisionsRequest struct {
+}
+
+func (m *ListRequestPolicyDecisionsRequest) Reset()         { *m = ListRequestPolicyDecisionsRequest{} }
+func (m *ListRequestPolicyDecisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRequestPolicyDecisionsRequest) ProtoMessage()    {}
+func (*ListRequestPolicyDecisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{32}
+}
+
+type ListRequestPolicyDecisionsReply struct {
+	Decisions []*RequestPolicyDecision `protobuf:"bytes,1,rep,name=decisions" json:"decisions,omitempty"`
+}
+
+func (m *ListRequestPolicyDecisionsReply) Reset()         { *m = ListRequestPolicyDecisionsReply{} }
+func (m *ListRequestPolicyDecisionsReply) String() string { return proto.CompactTextString(m) }
+func (*ListRequestPolicyDecisionsReply) ProtoMessage()    {}
+func (*ListRequestPolicyDecisionsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{33}
+}
+
+func (m *ListRequestPolicyDecisionsReply) GetDecisions() []*RequestPolicyDecision {
+	if m != nil {
+		return m.Decisions
+	}
+	return nil
+}
+
+// ContactRecord is a contact in the form used to export and import contacts
+type ContactRecord struct {
+	Address  string   `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	Nickname string   `protobuf:"bytes,2,opt,name=nickname" json:"nickname,omitempty"`
+	Tags     []string `protobuf:"bytes,3,rep,name=tags" json:"tags,omitempty"`
+	Notes    string   `protobuf:"bytes,4,opt,name=notes" json:"notes,omitempty"`
+	// Message for the contact request sent on import. When exporting, the
+	// message of an outbound request that wasn't accepted yet.
+	Message string `protobuf:"bytes,5,opt,name=message" json:"message,omitempty"`
+}
+
+func (m *ContactRecord) Reset()                    { *m = ContactRecord{} }
+func (m *ContactRecord) String() string            { return proto.CompactTextString(m) }
+func (*ContactRecord) ProtoMessage()               {}
+func (*ContactRecord) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *ContactRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContactRecord) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
+}
+
+func (m *ContactRecord) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ContactRecord) GetNotes() string {
+	if m != nil {
+		return m.Notes
+	}
+	return ""
+}
+
+func (m *ContactRecord) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type ExportContactsRequest struct {
+	Format ContactRecordFormat `protobuf:"varint,1,opt,name=format,enum=ricochet.ContactRecordFormat" json:"format,omitempty"`
+	// Contacts to export as a ContactSelector, e.g. "#tag"; empty is all
+	Selector string `protobuf:"bytes,2,opt,name=selector" json:"selector,omitempty"`
+}
+
+func (m *ExportContactsRequest) Reset()                    { *m = ExportContactsRequest{} }
+func (m *ExportContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportContactsRequest) ProtoMessage()               {}
+func (*ExportContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *ExportContactsRequest) GetFormat() ContactRecordFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ContactRecordFormat_JSON
+}
+
+func (m *ExportContactsRequest) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+type ExportContactsReply struct {
+	Data  []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Count int32  `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *ExportContactsReply) Reset()                    { *m = ExportContactsReply{} }
+func (m *ExportContactsReply) String() string            { return proto.CompactTextString(m) }
+func (*ExportContactsReply) ProtoMessage()               {}
+func (*ExportContactsReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *ExportContactsReply) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ExportContactsReply) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ImportContactsRequest struct {
+	Format ContactRecordFormat `protobuf:"varint,1,opt,name=format,enum=ricochet.ContactRecordFormat" json:"format,omitempty"`
+	Data   []byte              `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Our nickname sent with each contact request
+	FromNickname string `protobuf:"bytes,3,opt,name=fromNickname" json:"fromNickname,omitempty"`
+	// Seconds to wait between contact requests; zero uses the default
+	Interval uint32 `protobuf:"varint,4,opt,name=interval" json:"interval,omitempty"`
+}
+
+func (m *ImportContactsRequest) Reset()                    { *m = ImportContactsRequest{} }
+func (m *ImportContactsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportContactsRequest) ProtoMessage()               {}
+func (*ImportContactsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+
+func (m *ImportContactsRequest) GetFormat() ContactRecordFormat {
+	if m != nil {
+		return m.Format
+	}
+	return ContactRecordFormat_JSON
+}
+
+func (m *ImportContactsRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ImportContactsRequest) GetFromNickname() string {
+	if m != nil {
+		return m.FromNickname
+	}
+	return ""
+}
+
+func (m *ImportContactsRequest) GetInterval() uint32 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+// ImportContactResult is the result of importing one record
+type ImportContactResult struct {
+	// Number of the record, starting from 1
+	Record   int32                      `protobuf:"varint,1,opt,name=record" json:"record,omitempty"`
+	Address  string                     `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
+	Nickname string                     `protobuf:"bytes,3,opt,name=nickname" json:"nickname,omitempty"`
+	Result   ImportContactResult_Result `protobuf:"varint,4,opt,name=result,enum=ricochet.ImportContactResult_Result" json:"result,omitempty"`
+	Error    string                     `protobuf:"bytes,5,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ImportContactResult) Reset()                    { *m = ImportContactResult{} }
+func (m *ImportContactResult) String() string            { return proto.CompactTextString(m) }
+func (*ImportContactResult) ProtoMessage()               {}
+func (*ImportContactResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+
+func (m *ImportContactResult) GetRecord() int32 {
+	if m != nil {
+		return m.Record
+	}
+	return 0
+}
+
+func (m *ImportContactResult) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ImportContactResult) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
+}
+
+func (m *ImportContactResult) GetResult() ImportContactResult_Result {
+	if m != nil {
+		return m.Result
+	}
+	return ImportContactResult_ADDED
+}
+
+func (m *ImportContactResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// Introduction is a contact that one of our contacts suggested we add. It's
+// sent as a message that has a line with "introduce:" followed by a contact
+// URI, or "introduce-mutual:" if the contact was also told about us.
+type Introduction struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// Nickname of the contact used by the introducer
+	Nickname string `protobuf:"bytes,2,opt,name=nickname" json:"nickname,omitempty"`
+	// Address of the contact who sent the introduction; empty if we did
+	IntroducedBy string `protobuf:"bytes,3,opt,name=introducedBy" json:"introducedBy,omitempty"`
+	WhenReceived string `protobuf:"bytes,4,opt,name=whenReceived" json:"whenReceived,omitempty"`
+	Mutual       bool   `protobuf:"varint,5,opt,name=mutual" json:"mutual,omitempty"`
+	// Single-use invite token created by the introduced contact, which lets
+	// a contact request to them be accepted automatically. Only set if they
+	// gave it to the introducer to pass on.
+	Invite string `protobuf:"bytes,6,opt,name=invite" json:"invite,omitempty"`
+}
+
+func (m *Introduction) Reset()                    { *m = Introduction{} }
+func (m *Introduction) String() string            { return proto.CompactTextString(m) }
+func (*Introduction) ProtoMessage()               {}
+func (*Introduction) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+
+func (m *Introduction) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Introduction) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
+}
+
+func (m *Introduction) GetIntroducedBy() string {
+	if m != nil {
+		return m.IntroducedBy
+	}
+	return ""
+}
+
+func (m *Introduction) GetWhenReceived() string {
+	if m != nil {
+		return m.WhenReceived
+	}
+	return ""
+}
+
+func (m *Introduction) GetMutual() bool {
+	if m != nil {
+		return m.Mutual
+	}
+	return false
+}
+
+func (m *Introduction) GetInvite() string {
+	if m != nil {
+		return m.Invite
+	}
+	return ""
+}
+
+type IntroduceContactRequest struct {
+	// Contact who receives the introduction
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// Contact being introduced, or empty to introduce ourselves with a
+	// single-use invite that the contact can pass on in introductions of us
+	IntroducedAddress string `protobuf:"bytes,2,opt,name=introducedAddress" json:"introducedAddress,omitempty"`
+	// Also introduce the recipient to the introduced contact
+	Mutual bool `protobuf:"varint,3,opt,name=mutual" json:"mutual,omitempty"`
+}
+
+func (m *IntroduceContactRequest) Reset()                    { *m = IntroduceContactRequest{} }
+func (m *IntroduceContactRequest) String() string            { return proto.CompactTextString(m) }
+func (*IntroduceContactRequest) ProtoMessage()               {}
+func (*IntroduceContactRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
+
+func (m *IntroduceContactRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *IntroduceContactRequest) GetIntroducedAddress() string {
+	if m != nil {
+		return m.IntroducedAddress
+	}
+	return ""
+}
+
+func (m *IntroduceContactRequest) GetMutual() bool {
+	if m != nil {
+		return m.Mutual
+	}
+	return false
+}
+
+type IntroduceContactReply struct {
+}
+
+func (m *IntroduceContactReply) Reset()                    { *m = IntroduceContactReply{} }
+func (m *IntroduceContactReply) String() string            { return proto.CompactTextString(m) }
+func (*IntroduceContactReply) ProtoMessage()               {}
+func (*IntroduceContactReply) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+
+// ContactURI is a link to add a contact, in the form
+// "ricochet:<host>?nickname=<name>&message=<text>&invite=<token>". All of
+// the query parameters are optional.
+type ContactURI struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// Suggested nickname for the contact
+	Nickname string `protobuf:"bytes,2,opt,name=nickname" json:"nickname,omitempty"`
+	// Suggested message for the contact request
+	Message string `protobuf:"bytes,3,opt,name=message" json:"message,omitempty"`
+	// Invite token to include in the contact request
+	Invite string `protobuf:"bytes,4,opt,name=invite" json:"invite,omitempty"`
+	// The complete URI in normalized form
+	Uri string `protobuf:"bytes,5,opt,name=uri" json:"uri,omitempty"`
+}
+
+func (m *ContactURI) Reset()                    { *m = ContactURI{} }
+func (m *ContactURI) String() string            { return proto.CompactTextString(m) }
+func (*ContactURI) ProtoMessage()               {}
+func (*ContactURI) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
+
+func (m *ContactURI) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ContactURI) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
+}
+
+func (m *ContactURI) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ContactURI) GetInvite() string {
+	if m != nil {
+		return m.Invite
+	}
+	return ""
+}
+
+func (m *ContactURI) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+type ParseContactURIRequest struct {
+	Uri string `protobuf:"bytes,1,opt,name=uri" json:"uri,omitempty"`
+}
+
+func (m *ParseContactURIRequest) Reset()                    { *m = ParseContactURIRequest{} }
+func (m *ParseContactURIRequest) String() string            { return proto.CompactTextString(m) }
+func (*ParseContactURIRequest) ProtoMessage()               {}
+func (*ParseContactURIRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+
+func (m *ParseContactURIRequest) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+type VerificationCodeRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+}
+
+func (m *VerificationCodeRequest) Reset()                    { *m = VerificationCodeRequest{} }
+func (m *VerificationCodeRequest) String() string            { return proto.CompactTextString(m) }
+func (*VerificationCodeRequest) ProtoMessage()               {}
+func (*VerificationCodeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+
+func (m *VerificationCodeRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// VerificationCode is derived from our address and the contact's address,
+// which are fingerprints of the public keys authenticated for every
+// connection. Both parties see the same code, and can compare it in person
+// or over another trusted channel to confirm the contact's identity.
+type VerificationCode struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// Groups of digits separated by spaces
+	Code     string `protobuf:"bytes,2,opt,name=code" json:"code,omitempty"`
+	Verified bool   `protobuf:"varint,3,opt,name=verified" json:"verified,omitempty"`
+}
+
+func (m *VerificationCode) Reset()                    { *m = VerificationCode{} }
+func (m *VerificationCode) String() string            { return proto.CompactTextString(m) }
+func (*VerificationCode) ProtoMessage()               {}
+func (*VerificationCode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+
+func (m *VerificationCode) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VerificationCode) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *VerificationCode) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+type MarkVerifiedRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// False to clear the verified flag
+	Verified bool `protobuf:"varint,2,opt,name=verified" json:"verified,omitempty"`
+}
+
+func (m *MarkVerifiedRequest) Reset()                    { *m = MarkVerifiedRequest{} }
+func (m *MarkVerifiedRequest) String() string            { return proto.CompactTextString(m) }
+func (*MarkVerifiedRequest) ProtoMessage()               {}
+func (*MarkVerifiedRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+
+func (m *MarkVerifiedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MarkVerifiedRequest) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
 
 func init() {
 	proto.RegisterType((*Contact)(nil), "ricochet.Contact")
+	proto.RegisterType((*MetadataValue)(nil), "ricochet.MetadataValue")
 	proto.RegisterType((*ContactRequest)(nil), "ricochet.ContactRequest")
+	proto.RegisterType((*BlockedContact)(nil), "ricochet.BlockedContact")
+	proto.RegisterType((*ContactHistory)(nil), "ricochet.ContactHistory")
+	proto.RegisterType((*ConnectionRecord)(nil), "ricochet.ConnectionRecord")
+	proto.RegisterType((*ContactHistoryRequest)(nil), "ricochet.ContactHistoryRequest")
 	proto.RegisterType((*MonitorContactsRequest)(nil), "ricochet.MonitorContactsRequest")
 	proto.RegisterType((*ContactEvent)(nil), "ricochet.ContactEvent")
 	proto.RegisterType((*AddContactReply)(nil), "ricochet.AddContactReply")
+	proto.RegisterType((*UpdateContactRequest)(nil), "ricochet.UpdateContactRequest")
 	proto.RegisterType((*DeleteContactRequest)(nil), "ricochet.DeleteContactRequest")
 	proto.RegisterType((*DeleteContactReply)(nil), "ricochet.DeleteContactReply")
 	proto.RegisterType((*RejectInboundRequestReply)(nil), "ricochet.RejectInboundRequestReply")
+	proto.RegisterType((*WithdrawOutboundRequestReply)(nil), "ricochet.WithdrawOutboundRequestReply")
+	proto.RegisterType((*BlockContactRequest)(nil), "ricochet.BlockContactRequest")
+	proto.RegisterType((*UnblockContactRequest)(nil), "ricochet.UnblockContactRequest")
+	proto.RegisterType((*UnblockContactReply)(nil), "ricochet.UnblockContactReply")
+	proto.RegisterType((*ListBlockedContactsRequest)(nil), "ricochet.ListBlockedContactsRequest")
+	proto.RegisterType((*ListBlockedContactsReply)(nil), "ricochet.ListBlockedContactsReply")
+	proto.RegisterType((*InboundRequestStatsRequest)(nil), "ricochet.InboundRequestStatsRequest")
+	proto.RegisterType((*InboundRequestStats)(nil), "ricochet.InboundRequestStats")
+	proto.RegisterType((*Invite)(nil), "ricochet.Invite")
+	proto.RegisterType((*CreateInviteRequest)(nil), "ricochet.CreateInviteRequest")
+	proto.RegisterType((*ListInvitesRequest)(nil), "ricochet.ListInvitesRequest")
+	proto.RegisterType((*ListInvitesReply)(nil), "ricochet.ListInvitesReply")
+	proto.RegisterType((*RevokeInviteRequest)(nil), "ricochet.RevokeInviteRequest")
+	proto.RegisterType((*RevokeInviteReply)(nil), "ricochet.RevokeInviteReply")
+	proto.RegisterType((*RequestPolicy)(nil), "ricochet.RequestPolicy")
+	proto.RegisterType((*RequestPolicyDecision)(nil), "ricochet.RequestPolicyDecision")
+	proto.RegisterType((*GetRequestPolicyRequest)(nil), "ricochet.GetRequestPolicyRequest")
+	proto.RegisterType((*SetRequestPolicyRequest)(nil), "ricochet.SetRequestPolicyRequest")
+	proto.RegisterType((*ListRequestPolicyDecisionsRequest)(nil), "ricochet.ListRequestPolicyDecisionsRequest")
+	proto.RegisterType((*ListRequestPolicyDecisionsReply)(nil), "ricochet.ListRequestPolicyDecisionsReply")
+	proto.RegisterType((*ContactRecord)(nil), "ricochet.ContactRecord")
+	proto.RegisterType((*ExportContactsRequest)(nil), "ricochet.ExportContactsRequest")
+	proto.RegisterType((*ExportContactsReply)(nil), "ricochet.ExportContactsReply")
+	proto.RegisterType((*ImportContactsRequest)(nil), "ricochet.ImportContactsRequest")
+	proto.RegisterType((*ImportContactResult)(nil), "ricochet.ImportContactResult")
+	proto.RegisterType((*Introduction)(nil), "ricochet.Introduction")
+	proto.RegisterType((*IntroduceContactRequest)(nil), "ricochet.IntroduceContactRequest")
+	proto.RegisterType((*IntroduceContactReply)(nil), "ricochet.IntroduceContactReply")
+	proto.RegisterType((*ContactURI)(nil), "ricochet.ContactURI")
+	proto.RegisterType((*ParseContactURIRequest)(nil), "ricochet.ParseContactURIRequest")
+	proto.RegisterType((*VerificationCodeRequest)(nil), "ricochet.VerificationCodeRequest")
+	proto.RegisterType((*VerificationCode)(nil), "ricochet.VerificationCode")
+	proto.RegisterType((*MarkVerifiedRequest)(nil), "ricochet.MarkVerifiedRequest")
+	proto.RegisterEnum("ricochet.ContactRecordFormat", ContactRecordFormat_name, ContactRecordFormat_value)
 	proto.RegisterEnum("ricochet.Contact_Status", Contact_Status_name, Contact_Status_value)
 	proto.RegisterEnum("ricochet.ContactRequest_Direction", ContactRequest_Direction_name, ContactRequest_Direction_value)
 	proto.RegisterEnum("ricochet.ContactEvent_Type", ContactEvent_Type_name, ContactEvent_Type_value)
+	proto.RegisterEnum("ricochet.RequestPolicy_Mode", RequestPolicy_Mode_name, RequestPolicy_Mode_value)
+	proto.RegisterEnum("ricochet.RequestPolicyDecision_Action", RequestPolicyDecision_Action_name, RequestPolicyDecision_Action_value)
+	proto.RegisterEnum("ricochet.ImportContactResult_Result", ImportContactResult_Result_name, ImportContactResult_Result_value)
 }
 
 func init() { proto.RegisterFile("contact.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x54, 0x5d, 0x73, 0x93, 0x40,
-	0x14, 0x2d, 0x01, 0xf9, 0xb8, 0x69, 0x2b, 0xdd, 0xe9, 0x38, 0xd8, 0xbe, 0x64, 0x76, 0x1c, 0x27,
-	0x2f, 0x62, 0xa7, 0xfa, 0xae, 0x6d, 0xa0, 0x63, 0x34, 0x42, 0xdd, 0xc2, 0xf8, 0x4c, 0x61, 0x9d,
-	0xa2, 0x29, 0x1b, 0x97, 0x4d, 0x35, 0x7f, 0xc3, 0x5f, 0xe3, 0xcf, 0xf2, 0x27, 0x38, 0xbb, 0x40,
-	0x9a, 0x0f, 0xf5, 0xc1, 0xb7, 0xbd, 0xe7, 0x9e, 0x0b, 0x87, 0x7b, 0xce, 0x02, 0x7b, 0x39, 0xab,
-	0x44, 0x96, 0x0b, 0x7f, 0xc6, 0x99, 0x60, 0xc8, 0xe6, 0x65, 0xce, 0xf2, 0x1b, 0x2a, 0xf0, 0xcf,
-	0x1e, 0x58, 0xa3, 0xa6, 0x87, 0x3c, 0xb0, 0xb2, 0xa2, 0xe0, 0xb4, 0xae, 0xbd, 0xde, 0x40, 0x1b,
-	0x3a, 0xa4, 0x2b, 0xd1, 0x11, 0xd8, 0x55, 0x99, 0x7f, 0xa9, 0xb2, 0x5b, 0xea, 0xe9, 0xaa, 0xb5,
-	0xac, 0xd1, 0x00, 0xfa, 0xdf, 0x6e, 0x68, 0x35, 0xe2, 0x34, 0x13, 0xb4, 0xf0, 0x0c, 0xd5, 0x5e,
-	0x85, 0xd0, 0x13, 0xd8, 0x9b, 0x66, 0xb5, 0x18, 0xb1, 0xaa, 0xa2, 0xb9, 0xe4, 0x3c, 0x50, 0x9c,
-	0x75, 0x10, 0x9d, 0x82, 0xc5, 0xe9, 0xd7, 0x39, 0xad, 0x85, 0x67, 0x0e, 0xb4, 0x61, 0xff, 0xd4,
-	0xf3, 0x3b, 0x95, 0x7e, 0xab, 0x90, 0x34, 0x7d, 0xd2, 0x11, 0xd1, 0x09, 0x98, 0xb5, 0xc8, 0xc4,
-	0xbc, 0xf6, 0x60, 0xa0, 0x0d, 0xf7, 0xff, 0x30, 0xe2, 0x5f, 0xa9, 0x3e, 0x69, 0x79, 0x78, 0x0c,
-	0x66, 0x83, 0xa0, 0x3e, 0x58, 0x69, 0xf4, 0x2e, 0x8a, 0x3f, 0x46, 0xee, 0x8e, 0x2c, 0xe2, 0x8b,
-	0x8b, 0xc9, 0x38, 0x0a, 0x5d, 0x0d, 0x01, 0x98, 0x71, 0xa4, 0xce, 0x3d, 0xd9, 0x20, 0xe1, 0x87,
-	0x34, 0xbc, 0x4a, 0x5c, 0x1d, 0xed, 0x82, 0x4d, 0xc2, 0xb7, 0xe1, 0x28, 0x09, 0x03, 0xd7, 0xc0,
-	0x3f, 0x74, 0xd8, 0x5f, 0x17, 0x86, 0x5e, 0x83, 0x53, 0x94, 0x9c, 0xe6, 0xa2, 0x64, 0x95, 0xa7,
-	0x29, 0x49, 0xf8, 0x6f, 0x5f, 0xe1, 0x07, 0x1d, 0x93, 0xdc, 0x0f, 0xfd, 0xa7, 0x07, 0x08, 0x0c,
-	0x41, 0xbf, 0x8b, 0x76, 0xf9, 0xea, 0x8c, 0x30, 0xec, 0x7e, 0xe2, 0xec, 0x36, 0xea, 0x66, 0x9a,
-	0xa5, 0xaf, 0x61, 0x9b, 0xde, 0x99, 0xdb, 0xde, 0x1d, 0x81, 0xcd, 0xe9, 0xe7, 0xc6, 0x36, 0x6b,
-	0xa0, 0x0d, 0x6d, 0xb2, 0xac, 0xa5, 0xaf, 0x92, 0x1a, 0xd0, 0x69, 0x79, 0x47, 0x39, 0x2d, 0x3c,
-	0xbb, 0xf1, 0x75, 0x0d, 0x94, 0x3a, 0x24, 0x40, 0xba, 0xa7, 0x38, 0x8d, 0x8e, 0x55, 0x4c, 0xea,
-	0xe0, 0xf4, 0x96, 0x09, 0x1a, 0x72, 0xce, 0xb8, 0x32, 0xd3, 0x21, 0xab, 0x10, 0x7e, 0x0a, 0xce,
-	0x72, 0x5f, 0xd2, 0x94, 0x71, 0x74, 0x1e, 0xa7, 0x51, 0xe0, 0xee, 0x48, 0x53, 0xe2, 0x34, 0x69,
-	0x2a, 0x0d, 0x7b, 0xf0, 0xe8, 0x3d, 0xab, 0x4a, 0xc1, 0x78, 0xbb, 0xed, 0xba, 0x5d, 0x37, 0xfe,
-	0xa5, 0xc1, 0x6e, 0x8b, 0x85, 0x77, 0xb4, 0x12, 0xe8, 0x39, 0x18, 0x62, 0x31, 0xa3, 0xad, 0x4f,
-	0xc7, 0x5b, 0x3e, 0x29, 0x96, 0x9f, 0x2c, 0x66, 0x94, 0x28, 0x22, 0x7a, 0x06, 0x56, 0x7b, 0x8d,
-	0x94, 0x37, 0xfd, 0xd3, 0x83, 0xad, 0x99, 0x37, 0x3b, 0xa4, 0xe3, 0xa0, 0x97, 0xf7, 0x81, 0xd6,
-	0xff, 0x1d, 0x68, 0x39, 0xd5, 0x52, 0xf1, 0x2b, 0x30, 0xe4, 0x2b, 0x91, 0x0d, 0x46, 0x94, 0x4e,
-	0x26, 0xcd, 0x07, 0x5e, 0xc6, 0x97, 0xe9, 0xe4, 0x2c, 0x91, 0xe1, 0xb4, 0x40, 0x3f, 0x0b, 0x02,
-	0xb7, 0x27, 0x53, 0x9a, 0x5e, 0x06, 0x12, 0xd4, 0xe5, 0x39, 0x08, 0x27, 0x61, 0x12, 0xba, 0xc6,
-	0xb9, 0x03, 0x56, 0x3d, 0xbf, 0x96, 0x8b, 0xc5, 0x07, 0xf0, 0xf0, 0xac, 0x28, 0x96, 0xef, 0x9a,
-	0x4d, 0x17, 0xf8, 0x04, 0x0e, 0x03, 0x3a, 0xa5, 0x82, 0x6e, 0x24, 0x77, 0x25, 0x77, 0xda, 0x5a,
-	0xee, 0xf0, 0x21, 0xa0, 0x8d, 0x09, 0xf9, 0x9c, 0x63, 0x78, 0xdc, 0xb8, 0x37, 0xae, 0xae, 0xd9,
-	0xbc, 0x2a, 0xba, 0xab, 0x29, 0x9b, 0xd7, 0xa6, 0xfa, 0xcb, 0xbc, 0xf8, 0x1d, 0x00, 0x00, 0xff,
-	0xff, 0x2d, 0x76, 0xf2, 0x61, 0x76, 0x04, 0x00, 0x00,
+	// 2012 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x18, 0xcb, 0x72, 0xe3, 0xc6,
+	0x51, 0x20, 0x21, 0x3e, 0x5a, 0x8f, 0xe5, 0x0e, 0xf5, 0x80, 0xe5, 0x4d, 0xac, 0x20, 0xae, 0x2d,
+	0xd5, 0xda, 0xd6, 0xae, 0xe5, 0xa4, 0xca, 0x95, 0x38, 0x76, 0x28, 0x91, 0x5a, 0x71, 0x97, 0xa2,
+	0xe8, 0x11, 0xb9, 0x3e, 0x24, 0x95, 0x04, 0x02, 0x46, 0x12, 0x2c, 0x10, 0x60, 0x80, 0xa1, 0xb4,
+	0xfc, 0x81, 0x54, 0xe5, 0x37, 0xf2, 0x09, 0x39, 0xfb, 0x90, 0x5b, 0x4e, 0x39, 0xe5, 0x1b, 0x72,
+	0xcf, 0x29, 0xe7, 0x54, 0xcf, 0x03, 0x24, 0x40, 0x6a, 0xbd, 0xde, 0xf2, 0x09, 0xd3, 0xaf, 0xe9,
+	0x9e, 0x9e, 0x7e, 0x0d, 0x60, 0xcd, 0x8d, 0x42, 0xee, 0xb8, 0x7c, 0x7f, 0x14, 0x47, 0x3c, 0x22,
+	0x95, 0xd8, 0x77, 0x23, 0xf7, 0x9a, 0x71, 0xfb, 0x5f, 0x26, 0x94, 0x8f, 0x24, 0x8d, 0x58, 0x50,
+	0x76, 0x3c, 0x2f, 0x66, 0x49, 0x62, 0x15, 0x76, 0x8d, 0xbd, 0x2a, 0xd5, 0x20, 0xd9, 0x81, 0x4a,
+	0xe8, 0xbb, 0x37, 0xa1, 0x33, 0x64, 0x56, 0x51, 0x90, 0x52, 0x98, 0xec, 0xc2, 0xca, 0xdd, 0x35,
+	0x0b, 0x8f, 0x62, 0xe6, 0x70, 0xe6, 0x59, 0xa6, 0x20, 0xcf, 0xa2, 0xc8, 0x87, 0xb0, 0x16, 0x38,
+	0x09, 0x3f, 0x8a, 0xc2, 0x90, 0xb9, 0xc8, 0xb3, 0x2c, 0x78, 0xb2, 0x48, 0x72, 0x00, 0xe5, 0x98,
+	0xfd, 0x79, 0xcc, 0x12, 0x6e, 0x95, 0x76, 0x8d, 0xbd, 0x95, 0x03, 0x6b, 0x5f, 0x5b, 0xb9, 0xaf,
+	0x2c, 0xa4, 0x92, 0x4e, 0x35, 0x23, 0x79, 0x06, 0xa5, 0x84, 0x3b, 0x7c, 0x9c, 0x58, 0xb0, 0x6b,
+	0xec, 0xad, 0x2f, 0x10, 0xd9, 0x3f, 0x17, 0x74, 0xaa, 0xf8, 0xf0, 0x24, 0xb7, 0x2c, 0xf6, 0x2f,
+	0x7d, 0xe6, 0x59, 0x2b, 0xbb, 0xc6, 0x5e, 0x85, 0xa6, 0x30, 0xb1, 0x61, 0x15, 0xcd, 0x7e, 0xa5,
+	0xe9, 0xab, 0xc2, 0xcc, 0x0c, 0x8e, 0x10, 0x30, 0xb9, 0x73, 0x95, 0x58, 0x6b, 0xbb, 0xc5, 0xbd,
+	0x2a, 0x15, 0x6b, 0xb2, 0x01, 0xcb, 0x61, 0xc4, 0x59, 0x62, 0xad, 0x0b, 0x01, 0x09, 0x90, 0x5f,
+	0x43, 0x65, 0xc8, 0xb8, 0xe3, 0x39, 0xdc, 0xb1, 0x1e, 0xec, 0x16, 0xf7, 0x56, 0x0e, 0x3e, 0x98,
+	0xb7, 0xee, 0x54, 0x71, 0xb4, 0x42, 0x1e, 0x4f, 0x68, 0x2a, 0x40, 0xb6, 0xa0, 0x74, 0xed, 0x7b,
+	0x1e, 0x0b, 0xad, 0x9a, 0x30, 0x52, 0x41, 0x3b, 0x7d, 0x58, 0xcb, 0x88, 0x90, 0x1a, 0x14, 0x6f,
+	0xd8, 0xc4, 0x32, 0x84, 0x66, 0x5c, 0x92, 0x4f, 0x60, 0xf9, 0xd6, 0x09, 0xc6, 0x4c, 0xdc, 0xe1,
+	0xca, 0xc1, 0xf6, 0x54, 0xa9, 0x96, 0x7c, 0x85, 0x64, 0x2a, 0xb9, 0x7e, 0x55, 0xf8, 0xdc, 0xb0,
+	0xdb, 0x50, 0x92, 0x6e, 0x22, 0x2b, 0x50, 0x1e, 0x74, 0x5f, 0x76, 0xcf, 0xbe, 0xe9, 0xd6, 0x96,
+	0x10, 0x38, 0x3b, 0x3e, 0xee, 0xb4, 0xbb, 0xad, 0x9a, 0x41, 0x00, 0x4a, 0x67, 0x5d, 0xb1, 0x2e,
+	0x20, 0x81, 0xb6, 0xbe, 0x1e, 0xb4, 0xce, 0xfb, 0xb5, 0x22, 0x59, 0x85, 0x0a, 0x6d, 0xbd, 0x68,
+	0x1d, 0xf5, 0x5b, 0xcd, 0x9a, 0x69, 0xff, 0x01, 0xd6, 0x32, 0x6a, 0xc8, 0x06, 0x98, 0x9c, 0xbd,
+	0xe6, 0xd2, 0xc2, 0x93, 0x25, 0x2a, 0x20, 0x62, 0x41, 0x29, 0x1c, 0x0f, 0x2f, 0x58, 0x2c, 0xac,
+	0x2c, 0x9e, 0x2c, 0x51, 0x05, 0x23, 0xff, 0x65, 0xe0, 0x5c, 0x89, 0x30, 0xab, 0x20, 0x3f, 0x42,
+	0x87, 0x65, 0x75, 0x28, 0xfb, 0x7f, 0x45, 0x58, 0xcf, 0x46, 0x03, 0xf9, 0x2d, 0x54, 0x3d, 0x3f,
+	0x66, 0x2e, 0xf7, 0xa3, 0x50, 0xa8, 0x59, 0x3f, 0xb0, 0xef, 0x0b, 0x9d, 0xfd, 0xa6, 0xe6, 0xa4,
+	0x53, 0xa1, 0x77, 0x0c, 0x7c, 0xa2, 0x4e, 0x26, 0x23, 0x5e, 0x9e, 0xcb, 0x86, 0xd5, 0xcb, 0x38,
+	0x1a, 0x76, 0xb5, 0x8c, 0x8c, 0xf4, 0x0c, 0x2e, 0x9f, 0x30, 0xa5, 0xf9, 0x84, 0xd9, 0x81, 0x4a,
+	0xcc, 0xbe, 0x95, 0xb9, 0x52, 0x96, 0x41, 0xaa, 0x61, 0x4c, 0x26, 0x64, 0x6d, 0xb2, 0xc0, 0xbf,
+	0x65, 0x31, 0xf3, 0xac, 0x8a, 0x4c, 0xa6, 0x0c, 0x52, 0x87, 0x32, 0xd5, 0xbb, 0x54, 0xa7, 0xa1,
+	0xac, 0x71, 0x68, 0x47, 0xcc, 0x86, 0x11, 0x67, 0xad, 0x38, 0x8e, 0x62, 0x91, 0x41, 0x55, 0x3a,
+	0x8b, 0x42, 0xbf, 0xb0, 0xd7, 0x23, 0x3f, 0x4e, 0x73, 0x45, 0x83, 0xe4, 0x31, 0xac, 0x27, 0xfe,
+	0xd0, 0x0f, 0x9c, 0x58, 0xf9, 0x57, 0x25, 0x4b, 0x0e, 0xab, 0xcf, 0x4a, 0x19, 0x8f, 0x31, 0xa3,
+	0xd6, 0xa6, 0x67, 0x55, 0x28, 0xfb, 0x31, 0x54, 0xd3, 0x3b, 0xc1, 0xc0, 0x6a, 0x77, 0x0f, 0xcf,
+	0x06, 0xdd, 0x66, 0x6d, 0x09, 0x03, 0xeb, 0x6c, 0xd0, 0x97, 0x90, 0x61, 0x77, 0x60, 0xfd, 0x30,
+	0x88, 0xdc, 0x1b, 0xe6, 0x2d, 0x28, 0x57, 0x46, 0xf6, 0xd6, 0x94, 0x56, 0xc5, 0xaf, 0xee, 0x74,
+	0x16, 0x65, 0x5f, 0xa7, 0x51, 0x74, 0xe2, 0x27, 0x3c, 0x8a, 0x27, 0x6f, 0xd8, 0xed, 0x0b, 0x58,
+	0x71, 0x65, 0x95, 0xf2, 0xa3, 0x10, 0x23, 0x04, 0x73, 0x79, 0x27, 0x13, 0x61, 0x8a, 0x48, 0x99,
+	0x1b, 0xc5, 0x1e, 0x9d, 0x65, 0xb7, 0xbf, 0x33, 0xa0, 0x96, 0xe7, 0xd0, 0x97, 0x38, 0xad, 0x88,
+	0xc6, 0xf4, 0x12, 0x53, 0x24, 0x79, 0x02, 0x35, 0x71, 0xab, 0x7e, 0xe2, 0xa6, 0x8c, 0xf2, 0x2c,
+	0x73, 0x78, 0x0c, 0x19, 0x6f, 0x1c, 0x3b, 0x22, 0x07, 0x30, 0x50, 0x8b, 0x34, 0x85, 0xf1, 0x68,
+	0x7e, 0x78, 0x11, 0x8d, 0x43, 0x59, 0x9d, 0x2b, 0x54, 0x83, 0xe8, 0x28, 0x3f, 0xe4, 0x2c, 0x8e,
+	0xc7, 0x23, 0x5d, 0x97, 0x2b, 0x74, 0x16, 0x65, 0x7f, 0x0a, 0x9b, 0x59, 0x47, 0xe9, 0xac, 0xbb,
+	0xd7, 0x5f, 0xb6, 0x05, 0x5b, 0xa7, 0x51, 0xe8, 0xf3, 0x48, 0x47, 0x41, 0xa2, 0x64, 0xec, 0xff,
+	0x1a, 0xb0, 0xaa, 0x70, 0xad, 0x5b, 0x16, 0x72, 0xf2, 0x14, 0x4c, 0x3e, 0x19, 0x31, 0x95, 0xb5,
+	0xef, 0xcf, 0x65, 0xad, 0xe0, 0xda, 0xef, 0x4f, 0x46, 0x8c, 0x0a, 0x46, 0xf2, 0x09, 0x94, 0x55,
+	0x27, 0x53, 0xe5, 0xed, 0xe1, 0x9c, 0xcc, 0xc9, 0x12, 0xd5, 0x3c, 0xe4, 0x17, 0xd3, 0x9e, 0x52,
+	0x7c, 0x73, 0x4f, 0x41, 0x29, 0xc5, 0x6a, 0x7f, 0x05, 0x26, 0xaa, 0x24, 0x15, 0x30, 0xbb, 0x83,
+	0x4e, 0x47, 0x86, 0x62, 0xef, 0xac, 0x37, 0xe8, 0x34, 0xfa, 0x58, 0x0a, 0xcb, 0x50, 0x6c, 0x34,
+	0x9b, 0xb5, 0x02, 0xd6, 0xc4, 0x41, 0xaf, 0x89, 0xc8, 0x22, 0xae, 0x9b, 0xad, 0x4e, 0xab, 0xdf,
+	0xaa, 0x99, 0x87, 0x55, 0x28, 0x27, 0xe3, 0x0b, 0x4c, 0x33, 0xfb, 0x21, 0x3c, 0x68, 0x78, 0x5e,
+	0xaa, 0x6b, 0x14, 0x4c, 0xec, 0xdf, 0xc1, 0xc6, 0x60, 0xe4, 0x39, 0x9c, 0xe5, 0xea, 0xd8, 0x47,
+	0xd3, 0xb3, 0x19, 0xf7, 0x9c, 0x6d, 0x7a, 0xb2, 0x2d, 0x28, 0x5d, 0xfa, 0x2c, 0xf0, 0x64, 0x3c,
+	0x56, 0xa9, 0x82, 0xec, 0x67, 0xb0, 0xd1, 0x64, 0x01, 0x9b, 0xdb, 0xfc, 0xfe, 0xeb, 0xda, 0x00,
+	0x92, 0x93, 0x40, 0x23, 0xdf, 0x87, 0xf7, 0x64, 0xa1, 0x68, 0xcb, 0x50, 0xd1, 0xad, 0x57, 0x10,
+	0x7f, 0x0a, 0x8f, 0xbe, 0xf1, 0xf9, 0xb5, 0x17, 0x3b, 0x77, 0x67, 0x63, 0x3e, 0x4f, 0x7f, 0x0a,
+	0x75, 0x91, 0x68, 0x6f, 0x6d, 0xc3, 0xa7, 0xb0, 0x39, 0x08, 0x2f, 0x7e, 0x90, 0xc8, 0x26, 0xd4,
+	0xf3, 0x22, 0xa8, 0xfa, 0x11, 0xec, 0x74, 0xfc, 0x84, 0x67, 0x4b, 0x45, 0x1a, 0x80, 0x5d, 0xb0,
+	0x16, 0x52, 0x47, 0xc1, 0x04, 0xe7, 0x8f, 0x0b, 0x89, 0xb7, 0x8c, 0xdd, 0x62, 0x36, 0x56, 0xb2,
+	0x02, 0x54, 0x33, 0xa2, 0xb6, 0xac, 0x7f, 0xb0, 0x8d, 0xa6, 0xda, 0xfe, 0x5d, 0x80, 0xfa, 0x02,
+	0x32, 0xd9, 0x83, 0x07, 0xd1, 0x28, 0x4d, 0x74, 0x51, 0x54, 0xf0, 0x70, 0xcb, 0x34, 0x8f, 0x46,
+	0xce, 0x11, 0x0b, 0x3d, 0x3f, 0xbc, 0x52, 0x1b, 0xc8, 0x06, 0xb5, 0x4c, 0xf3, 0x68, 0xcc, 0xe4,
+	0xd9, 0x22, 0x85, 0xd1, 0x6e, 0x66, 0x0a, 0x11, 0xf9, 0x1c, 0xb6, 0x75, 0x13, 0x99, 0xaa, 0xe8,
+	0xf8, 0x43, 0x5f, 0x76, 0x30, 0x93, 0xde, 0x47, 0xc6, 0x3a, 0x34, 0x25, 0x45, 0x81, 0x17, 0xdd,
+	0x85, 0xa2, 0x54, 0x98, 0x74, 0x0e, 0x4f, 0x0e, 0x60, 0x43, 0xe3, 0x7a, 0xd2, 0x44, 0xa9, 0xa2,
+	0x24, 0xf8, 0x17, 0xd2, 0xc8, 0xc7, 0xf0, 0x50, 0xe3, 0xa9, 0xc3, 0x99, 0x14, 0x28, 0x0b, 0x81,
+	0x79, 0x82, 0xfd, 0x0f, 0x03, 0x4a, 0xed, 0xf0, 0xd6, 0xe7, 0x38, 0x5b, 0x2c, 0xf3, 0xe8, 0x86,
+	0x85, 0x2a, 0x36, 0x24, 0x90, 0xe9, 0xd9, 0x85, 0x5c, 0xcf, 0xb6, 0xa0, 0x3c, 0x74, 0x5e, 0x0f,
+	0x12, 0x26, 0x5d, 0xb4, 0x46, 0x35, 0x88, 0xdd, 0x7c, 0x8c, 0x68, 0x53, 0xa0, 0xc5, 0x3a, 0xdf,
+	0xa9, 0x97, 0xe7, 0x3b, 0xb5, 0xe2, 0x68, 0x89, 0xb6, 0x98, 0xcc, 0xf6, 0x72, 0x85, 0xc2, 0x7d,
+	0x03, 0x3f, 0xbc, 0x11, 0xe7, 0xa9, 0x52, 0xb1, 0xb6, 0xaf, 0xa0, 0x2e, 0x37, 0x90, 0xe7, 0xd0,
+	0xc1, 0x3e, 0x6b, 0xb8, 0x71, 0xbf, 0xe1, 0x85, 0xac, 0xe1, 0x38, 0xd1, 0x3a, 0x81, 0xef, 0x1d,
+	0x47, 0xb1, 0x3a, 0x53, 0x0a, 0x63, 0x6e, 0x63, 0xbc, 0x4b, 0x35, 0x69, 0x5c, 0x7e, 0x09, 0xb5,
+	0x0c, 0x16, 0xa3, 0xff, 0x09, 0xf6, 0x08, 0x01, 0xab, 0xe8, 0xaf, 0x4d, 0xa3, 0x5f, 0x59, 0xa9,
+	0x19, 0xec, 0x8f, 0xa0, 0x4e, 0xd9, 0x6d, 0x74, 0x93, 0x33, 0x7f, 0xe1, 0x6d, 0xd8, 0x75, 0x78,
+	0x98, 0x65, 0xc6, 0x2c, 0xfd, 0x7b, 0x01, 0xd6, 0x94, 0x58, 0x2f, 0x0a, 0x7c, 0x77, 0x42, 0x9e,
+	0x81, 0x39, 0x8c, 0x3c, 0xdd, 0x09, 0x1e, 0x4d, 0x95, 0x67, 0xd8, 0xf6, 0x4f, 0x23, 0x8f, 0x51,
+	0xc1, 0x89, 0x23, 0xc8, 0x90, 0x25, 0x89, 0x73, 0xc5, 0x7a, 0x0e, 0xe7, 0x2c, 0x0e, 0xd5, 0x65,
+	0xe7, 0xb0, 0x38, 0x0a, 0x29, 0x4c, 0x5f, 0x58, 0x27, 0xc7, 0xb8, 0x0c, 0x0e, 0x23, 0xdc, 0x09,
+	0x82, 0xe8, 0x8e, 0x79, 0x0d, 0x59, 0x5e, 0x44, 0x20, 0x60, 0x5d, 0x9d, 0xc3, 0x63, 0x4e, 0xca,
+	0xa0, 0x1c, 0x84, 0x43, 0x87, 0xbb, 0xd7, 0x69, 0xdf, 0xcc, 0xa3, 0xed, 0xe7, 0x60, 0xa2, 0xbd,
+	0xd8, 0x1a, 0x4e, 0x1b, 0xdd, 0x41, 0x03, 0x3b, 0xc9, 0x3a, 0x40, 0xe3, 0xe8, 0xa8, 0xd5, 0xeb,
+	0xff, 0xb1, 0xd1, 0xe9, 0xd4, 0x0c, 0x84, 0xe5, 0xf4, 0x2c, 0xe0, 0x02, 0xa9, 0xc3, 0x03, 0x45,
+	0x3f, 0x6d, 0xf4, 0x8f, 0x4e, 0xda, 0xdd, 0xe7, 0xb5, 0xe2, 0x0b, 0xb3, 0x52, 0xaa, 0x95, 0xed,
+	0x7f, 0x16, 0x60, 0x33, 0xe3, 0x8d, 0x26, 0x73, 0xfd, 0x24, 0x37, 0xbf, 0x1a, 0xf7, 0xcf, 0xaf,
+	0x85, 0xf9, 0xf9, 0xf5, 0xee, 0x3a, 0x75, 0x88, 0x58, 0x93, 0x2f, 0xa1, 0xe4, 0xc8, 0x41, 0xda,
+	0x14, 0x17, 0xf1, 0xf8, 0x9e, 0x8b, 0xd0, 0xaa, 0xf7, 0x1b, 0x82, 0x9b, 0x2a, 0xa9, 0xf4, 0x1a,
+	0x97, 0xdf, 0xfa, 0x1a, 0xb7, 0xa0, 0x14, 0x33, 0x27, 0x89, 0x42, 0x95, 0x3c, 0x0a, 0x42, 0x37,
+	0xab, 0x5e, 0x97, 0x0e, 0xd3, 0x32, 0x85, 0xf2, 0x68, 0xfb, 0x19, 0x94, 0xa4, 0x15, 0xe8, 0xe8,
+	0xaf, 0x07, 0xad, 0x41, 0x4b, 0x4d, 0x8f, 0xd2, 0x91, 0xad, 0x66, 0xcd, 0xc8, 0x3c, 0x52, 0x0a,
+	0xf6, 0x7b, 0xb0, 0xfd, 0x9c, 0xf1, 0x8c, 0x49, 0x3a, 0x37, 0x5e, 0xc0, 0xf6, 0xf9, 0x62, 0x12,
+	0x79, 0x0a, 0xa5, 0x91, 0x40, 0x58, 0x46, 0xfe, 0x65, 0x95, 0xe5, 0x57, 0x6c, 0xf6, 0xcf, 0xe1,
+	0x67, 0x98, 0x67, 0x0b, 0x1d, 0x97, 0x26, 0xe3, 0x9f, 0xe0, 0x83, 0x37, 0x31, 0x61, 0x6e, 0xfe,
+	0x06, 0xaa, 0x9e, 0xc6, 0x58, 0x46, 0xfe, 0x29, 0xb9, 0x50, 0x92, 0x4e, 0x25, 0xec, 0xbf, 0x1a,
+	0xb0, 0x96, 0xf6, 0x48, 0x31, 0x7e, 0xbe, 0x73, 0xbc, 0x88, 0xa7, 0x6f, 0x71, 0xd1, 0xd3, 0xd7,
+	0x9c, 0x7d, 0xfa, 0x62, 0xb1, 0x92, 0xe9, 0xa5, 0x6a, 0xa6, 0x06, 0xed, 0x6f, 0x61, 0xb3, 0xf5,
+	0x7a, 0x14, 0xc5, 0x3c, 0xd7, 0x99, 0xc9, 0x2f, 0xa1, 0x74, 0x19, 0xc5, 0x43, 0x87, 0xab, 0x0a,
+	0xf0, 0x93, 0x05, 0x83, 0x1a, 0xda, 0x7e, 0x2c, 0x98, 0xa8, 0x62, 0x46, 0x7b, 0x13, 0x16, 0x30,
+	0x97, 0x47, 0xb1, 0xb6, 0x57, 0xc3, 0xf6, 0x57, 0x50, 0xcf, 0xeb, 0x42, 0x6f, 0x12, 0x30, 0xc5,
+	0x9b, 0x1c, 0xf5, 0xac, 0x52, 0xb1, 0xc6, 0x63, 0xb8, 0xd1, 0x38, 0xe4, 0xaa, 0xbb, 0x4a, 0xc0,
+	0xfe, 0x9b, 0x01, 0x9b, 0xed, 0xe1, 0x8f, 0x68, 0xad, 0x56, 0x5d, 0x98, 0x51, 0x9d, 0x7f, 0x31,
+	0x16, 0x17, 0xbc, 0x18, 0x77, 0xa0, 0x22, 0x66, 0xf2, 0x5b, 0x27, 0x50, 0xfd, 0x29, 0x85, 0xed,
+	0xff, 0x18, 0x50, 0xcf, 0x18, 0x49, 0x59, 0x32, 0x0e, 0xb8, 0xcc, 0x2b, 0xb4, 0x41, 0xcd, 0x16,
+	0x0a, 0x7a, 0xc7, 0xb7, 0xee, 0x17, 0xb8, 0x1b, 0xee, 0xab, 0xea, 0xc2, 0x87, 0x33, 0xdd, 0x61,
+	0x5e, 0xf9, 0xbe, 0xfc, 0x50, 0x25, 0x83, 0xee, 0x65, 0xe2, 0x8d, 0x29, 0xa3, 0x41, 0x02, 0xf6,
+	0xc7, 0x50, 0x52, 0xb6, 0x56, 0x61, 0xb9, 0xd1, 0x6c, 0x8a, 0xb4, 0x5d, 0x81, 0xf2, 0xf9, 0xcb,
+	0x76, 0xaf, 0x27, 0xb2, 0x16, 0xa0, 0x74, 0xdc, 0x68, 0x77, 0x44, 0xce, 0x7e, 0x67, 0xc0, 0x6a,
+	0x3b, 0xe4, 0x71, 0xe4, 0x8d, 0xe7, 0x1e, 0xed, 0x3f, 0x20, 0x88, 0x6d, 0x58, 0xf5, 0xd5, 0x2e,
+	0xcc, 0x3b, 0x9c, 0x68, 0x77, 0xcf, 0xe2, 0xa6, 0x8f, 0x67, 0x97, 0xf9, 0xb7, 0xe9, 0x2f, 0xad,
+	0x0c, 0x0e, 0xdd, 0x3b, 0x1c, 0xf3, 0xb1, 0x13, 0xa8, 0xe2, 0xaf, 0x20, 0xc4, 0xcb, 0x36, 0xa9,
+	0xcb, 0x99, 0x84, 0xec, 0x09, 0x6c, 0x6b, 0xeb, 0xdf, 0x7a, 0x34, 0xc7, 0xc1, 0x68, 0x6a, 0x58,
+	0x23, 0x73, 0x6b, 0xf3, 0x84, 0x19, 0x93, 0x8a, 0xb3, 0x26, 0xd9, 0xdb, 0xb0, 0x39, 0xaf, 0x1a,
+	0xbb, 0xf0, 0x5f, 0x0c, 0x00, 0x85, 0x18, 0xd0, 0xf6, 0x3b, 0x3a, 0x74, 0x26, 0xd7, 0x8b, 0x99,
+	0x5c, 0x9f, 0x71, 0x85, 0x39, 0xeb, 0x0a, 0xfc, 0x65, 0x35, 0x8e, 0x7d, 0x15, 0x0b, 0xb8, 0xb4,
+	0x9f, 0xc0, 0x56, 0xcf, 0x89, 0x13, 0x36, 0x35, 0x46, 0xfb, 0x46, 0xf1, 0x1a, 0x53, 0xde, 0xcf,
+	0x60, 0x5b, 0xfe, 0x8c, 0x73, 0xc5, 0xe3, 0xf6, 0x08, 0x3b, 0xc9, 0xf7, 0x3e, 0x16, 0x7e, 0x0f,
+	0xb5, 0xbc, 0xd0, 0x1b, 0x8e, 0x4b, 0xc0, 0x74, 0x23, 0x4f, 0x1f, 0x55, 0xac, 0x33, 0xff, 0x0d,
+	0x8b, 0xd9, 0xff, 0x86, 0xf6, 0x4b, 0xa8, 0x9f, 0x3a, 0xf1, 0x8d, 0xfe, 0x47, 0xf8, 0xfd, 0xf7,
+	0x3a, 0xbb, 0x59, 0x21, 0xbb, 0xd9, 0x93, 0x3d, 0xa8, 0x2f, 0x28, 0x21, 0xf8, 0x16, 0x7d, 0x71,
+	0x7e, 0x86, 0x7f, 0xe5, 0xca, 0x50, 0x3c, 0x3a, 0x7f, 0x55, 0x33, 0x2e, 0x4a, 0xe2, 0x5f, 0xee,
+	0x67, 0xff, 0x1f, 0x00, 0x19, 0x5c, 0x2d, 0xaa, 0xdc, 0x15, 0x00, 0x00,
 }
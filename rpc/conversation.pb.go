@@ -148,6 +148,10 @@ type Message struct {
 	Identifier uint64         `protobuf:"varint,4,opt,name=identifier" json:"identifier,omitempty"`
 	Status     Message_Status `protobuf:"varint,5,opt,name=status,enum=ricochet.Message_Status" json:"status,omitempty"`
 	Text       string         `protobuf:"bytes,6,opt,name=text" json:"text,omitempty"`
+	// Set when the text introduces a contact, which can be added with a
+	// contact request. Sent messages only have it if they were sent as an
+	// introduction, not for text that looks like one.
+	Introduction *Introduction `protobuf:"bytes,7,opt,name=introduction" json:"introduction,omitempty"`
 }
 
 func (m *Message) Reset()                    { *m = Message{} }
@@ -197,6 +201,13 @@ func (m *Message) GetText() string {
 	return ""
 }
 
+func (m *Message) GetIntroduction() *Introduction {
+	if m != nil {
+		return m.Introduction
+	}
+	return nil
+}
+
 type MarkConversationReadRequest struct {
 	Entity             *Entity `protobuf:"bytes,1,opt,name=entity" json:"entity,omitempty"`
 	LastRecvIdentifier uint64  `protobuf:"varint,2,opt,name=lastRecvIdentifier" json:"lastRecvIdentifier,omitempty"`
@@ -234,34 +245,36 @@ func init() {
 func init() { proto.RegisterFile("conversation.proto", fileDescriptor1) }
 
 var fileDescriptor1 = []byte{
-	// 453 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x74, 0x92, 0x41, 0x8f, 0x93, 0x4c,
-	0x18, 0xc7, 0x5f, 0x0a, 0xa5, 0xe5, 0xe9, 0xab, 0x99, 0x7d, 0x0e, 0x86, 0x64, 0xd5, 0x10, 0xbc,
-	0x70, 0x22, 0xa6, 0x7a, 0xf2, 0xd6, 0x2c, 0x13, 0xd3, 0xa4, 0xed, 0xd6, 0xe9, 0xb2, 0x17, 0x4f,
-	0x08, 0xcf, 0xae, 0x13, 0xb7, 0x80, 0xcc, 0x6c, 0xb5, 0x1f, 0xcb, 0xef, 0xe0, 0x07, 0x33, 0x4c,
-	0xd9, 0x40, 0x52, 0xbd, 0x31, 0xf3, 0xff, 0xcd, 0xe4, 0x99, 0xdf, 0x1f, 0xc0, 0xbc, 0x2a, 0x0f,
-	0xd4, 0xa8, 0x4c, 0xcb, 0xaa, 0x8c, 0xeb, 0xa6, 0xd2, 0x15, 0x4e, 0x1b, 0x99, 0x57, 0xf9, 0x57,
-	0xd2, 0xe1, 0x2f, 0x0b, 0x2e, 0xae, 0x06, 0x00, 0x3f, 0x50, 0xa9, 0xf1, 0x3d, 0x38, 0xfa, 0x58,
-	0x93, 0x6f, 0x05, 0x56, 0xf4, 0x7c, 0x1e, 0xc4, 0x4f, 0x78, 0x7c, 0x86, 0xc6, 0x37, 0xc7, 0x9a,
-	0x84, 0xa1, 0xf1, 0x0d, 0xd8, 0x7b, 0x75, 0xef, 0x8f, 0x02, 0x2b, 0x9a, 0xcd, 0x2f, 0xfa, 0x43,
-	0x6b, 0x52, 0x2a, 0xbb, 0x27, 0xd1, 0xa6, 0xe1, 0x02, 0x9c, 0xf6, 0x08, 0x4e, 0xc1, 0xd9, 0xa4,
-	0xab, 0x15, 0xfb, 0x0f, 0xff, 0x87, 0xe9, 0xf6, 0x7a, 0x9b, 0xae, 0x16, 0x37, 0x9c, 0x59, 0x38,
-	0x83, 0x89, 0xe0, 0x57, 0x7c, 0x79, 0xcb, 0xd9, 0xa8, 0x85, 0x76, 0x7c, 0x93, 0x30, 0x1b, 0x01,
-	0xdc, 0x74, 0x9b, 0xb4, 0x88, 0x13, 0xbe, 0x82, 0xcb, 0x75, 0x55, 0x4a, 0x5d, 0x35, 0xc3, 0x71,
-	0x94, 0xa0, 0xef, 0x8f, 0xa4, 0x74, 0xf8, 0x01, 0x5c, 0x5e, 0x6a, 0xa9, 0x8f, 0xe8, 0xc3, 0x24,
-	0x2b, 0x8a, 0x86, 0x94, 0x32, 0x43, 0x79, 0xe2, 0x69, 0x89, 0x2f, 0xc0, 0x95, 0x6a, 0x47, 0x0f,
-	0x77, 0xbe, 0x1d, 0x58, 0xd1, 0x54, 0x74, 0xab, 0xf0, 0xf7, 0x08, 0x26, 0xdd, 0xb8, 0x18, 0x81,
-	0xab, 0xa8, 0x2c, 0xa8, 0x31, 0x1a, 0x66, 0x73, 0xd6, 0xbf, 0xe8, 0x74, 0xbf, 0xe8, 0x72, 0x8c,
-	0xc1, 0x6b, 0x28, 0x97, 0xb5, 0xa4, 0x52, 0x77, 0xcf, 0x3f, 0x87, 0x7b, 0x04, 0x5f, 0x82, 0xa7,
-	0xe5, 0x9e, 0x94, 0xce, 0xf6, 0xb5, 0x19, 0xc0, 0x16, 0xfd, 0x06, 0xbe, 0x06, 0x90, 0x05, 0x95,
-	0x5a, 0xde, 0x49, 0x6a, 0x7c, 0x27, 0xb0, 0x22, 0x47, 0x0c, 0x76, 0xf0, 0x2d, 0xb8, 0x4a, 0x67,
-	0xfa, 0x51, 0xf9, 0x63, 0x53, 0x8f, 0x7f, 0x66, 0x3a, 0xde, 0x99, 0x5c, 0x74, 0x1c, 0x22, 0x38,
-	0x9a, 0x7e, 0x6a, 0xdf, 0x35, 0x12, 0xcc, 0x77, 0xf8, 0x19, 0xdc, 0x13, 0x35, 0x68, 0xc2, 0x83,
-	0x31, 0x17, 0xe2, 0x5a, 0x30, 0xab, 0xf5, 0xfd, 0x29, 0xe5, 0x29, 0x4f, 0xd8, 0xa8, 0xad, 0xa4,
-	0x6d, 0x61, 0xb9, 0xf9, 0xc8, 0x6c, 0x7c, 0x06, 0x5e, 0xc2, 0x57, 0xcb, 0x5b, 0x2e, 0x78, 0xc2,
-	0x1c, 0xd3, 0xcb, 0x46, 0xf0, 0x45, 0xc2, 0xc6, 0xed, 0x45, 0xe6, 0xcb, 0x0d, 0x7f, 0xc0, 0xe5,
-	0x3a, 0x6b, 0xbe, 0x0d, 0xeb, 0x11, 0x94, 0x15, 0x5d, 0x43, 0xad, 0x59, 0x32, 0x52, 0xfe, 0x6d,
-	0xf6, 0x94, 0x63, 0x0c, 0xf8, 0x90, 0x29, 0x2d, 0x28, 0x3f, 0x2c, 0x7b, 0x27, 0x23, 0xe3, 0xe4,
-	0x2f, 0xc9, 0x17, 0xd7, 0xfc, 0xdf, 0xef, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x9e, 0xac,
-	0x63, 0xf5, 0x02, 0x00, 0x00,
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x41, 0x6f, 0xda, 0x40,
+	0x10, 0x85, 0x6b, 0x6c, 0x0c, 0x0c, 0x49, 0xe5, 0xcc, 0x21, 0xb2, 0x9a, 0xb6, 0x42, 0xee, 0x85,
+	0x93, 0x55, 0xd1, 0x9e, 0x72, 0x43, 0xf1, 0xaa, 0x42, 0x02, 0x42, 0x97, 0x90, 0x4b, 0x4f, 0xae,
+	0x3d, 0x49, 0x57, 0x0d, 0x36, 0xf5, 0x0e, 0xb4, 0xdc, 0xfa, 0x97, 0xfa, 0x0f, 0xab, 0x5d, 0x88,
+	0xec, 0x2a, 0xcd, 0x6d, 0x77, 0xdf, 0x9b, 0xe1, 0xf1, 0x3e, 0x19, 0x30, 0x2b, 0x8b, 0x1d, 0x55,
+	0x3a, 0x65, 0x55, 0x16, 0xf1, 0xa6, 0x2a, 0xb9, 0xc4, 0x6e, 0xa5, 0xb2, 0x32, 0xfb, 0x46, 0xfc,
+	0xea, 0x34, 0x2b, 0x0b, 0x4e, 0x33, 0x3e, 0x08, 0xd1, 0x1f, 0x07, 0xce, 0xae, 0x1a, 0x7e, 0xb1,
+	0xa3, 0x82, 0xf1, 0x23, 0x78, 0xbc, 0xdf, 0x50, 0xe8, 0x0c, 0x9c, 0xe1, 0xcb, 0xd1, 0x20, 0x7e,
+	0x9c, 0x8e, 0x9f, 0x58, 0xe3, 0x9b, 0xfd, 0x86, 0xa4, 0x75, 0xe3, 0x3b, 0x70, 0xd7, 0xfa, 0x3e,
+	0x6c, 0x0d, 0x9c, 0x61, 0x7f, 0x74, 0x56, 0x0f, 0xcd, 0x48, 0xeb, 0xf4, 0x9e, 0xa4, 0x51, 0xa3,
+	0x31, 0x78, 0x66, 0x04, 0xbb, 0xe0, 0xcd, 0x57, 0xd3, 0x69, 0xf0, 0x02, 0x4f, 0xa0, 0xbb, 0xb8,
+	0x5e, 0xac, 0xa6, 0xe3, 0x1b, 0x11, 0x38, 0xd8, 0x87, 0x8e, 0x14, 0x57, 0x62, 0x72, 0x2b, 0x82,
+	0x96, 0x31, 0x2d, 0xc5, 0x3c, 0x09, 0x5c, 0x04, 0xf0, 0x57, 0x8b, 0xc4, 0x58, 0xbc, 0xe8, 0x0d,
+	0x5c, 0xcc, 0xca, 0x42, 0x71, 0x59, 0x35, 0xe3, 0x68, 0x49, 0x3f, 0xb6, 0xa4, 0x39, 0xba, 0x04,
+	0x5f, 0x14, 0xac, 0x78, 0x8f, 0x21, 0x74, 0xd2, 0x3c, 0xaf, 0x48, 0x6b, 0x1b, 0xaa, 0x27, 0x1f,
+	0xaf, 0x78, 0x0e, 0xbe, 0xd2, 0x4b, 0x7a, 0xb8, 0x0b, 0xdd, 0x81, 0x33, 0xec, 0xca, 0xe3, 0x2d,
+	0xfa, 0xed, 0x42, 0xe7, 0x18, 0x17, 0x87, 0xe0, 0x6b, 0x2a, 0x72, 0xaa, 0x6c, 0x0d, 0xfd, 0x51,
+	0x50, 0xff, 0xa3, 0xc3, 0x7e, 0x79, 0xd4, 0x31, 0x86, 0x5e, 0x45, 0x99, 0xda, 0x28, 0x2a, 0x38,
+	0x6c, 0x3d, 0x63, 0xae, 0x2d, 0xf8, 0x1a, 0x7a, 0xac, 0xd6, 0xa4, 0x39, 0x5d, 0x6f, 0x6c, 0x00,
+	0x57, 0xd6, 0x0f, 0xf8, 0x16, 0x40, 0xe5, 0x54, 0xb0, 0xba, 0x53, 0x54, 0x85, 0xde, 0xc0, 0x19,
+	0x7a, 0xb2, 0xf1, 0x82, 0xef, 0xc1, 0xd7, 0x9c, 0xf2, 0x56, 0x87, 0x6d, 0x8b, 0x27, 0x7c, 0xd2,
+	0x74, 0xbc, 0xb4, 0xba, 0x3c, 0xfa, 0x10, 0xc1, 0x63, 0xfa, 0xc5, 0xa1, 0x6f, 0x4b, 0xb0, 0x67,
+	0xbc, 0x84, 0x13, 0x55, 0x70, 0x55, 0xe6, 0xdb, 0xcc, 0xb4, 0x17, 0x76, 0x6c, 0xec, 0xf3, 0x7a,
+	0xd7, 0xa4, 0xa1, 0xca, 0x7f, 0xbc, 0xd1, 0x17, 0xf0, 0x0f, 0xbf, 0xd0, 0xa0, 0xd8, 0x83, 0xb6,
+	0x90, 0xf2, 0x5a, 0x06, 0x8e, 0x61, 0xf5, 0x79, 0x25, 0x56, 0x22, 0x09, 0x5a, 0x06, 0xa7, 0x21,
+	0x38, 0x99, 0x7f, 0x0a, 0x5c, 0x3c, 0x85, 0x5e, 0x22, 0xa6, 0x93, 0x5b, 0x21, 0x45, 0x12, 0x78,
+	0x96, 0xe9, 0x5c, 0x8a, 0x71, 0x12, 0xb4, 0xcd, 0x22, 0x7b, 0xf2, 0xa3, 0x9f, 0x70, 0x31, 0x4b,
+	0xab, 0xef, 0x4d, 0xb4, 0x92, 0xd2, 0xfc, 0x48, 0xd7, 0x50, 0x21, 0x5b, 0xe8, 0xf3, 0x54, 0x0e,
+	0x3a, 0xc6, 0x80, 0x0f, 0xa9, 0x66, 0x49, 0xd9, 0x6e, 0x52, 0xf7, 0xd9, 0xb2, 0x7d, 0xfe, 0x47,
+	0xf9, 0xea, 0xdb, 0x2f, 0xe2, 0xc3, 0xdf, 0x01, 0x00, 0x96, 0x29, 0xb2, 0xa6, 0x40, 0x03, 0x00,
+	0x00,
 }
@@ -43,8 +43,9 @@ func (m *ServerStatusRequest) GetRpcVersion() int32 {
 }
 
 type ServerStatusReply struct {
-	RpcVersion    int32  `protobuf:"varint,1,opt,name=rpcVersion" json:"rpcVersion,omitempty"`
-	ServerVersion string `protobuf:"bytes,2,opt,name=serverVersion" json:"serverVersion,omitempty"`
+	RpcVersion    int32         `protobuf:"varint,1,opt,name=rpcVersion" json:"rpcVersion,omitempty"`
+	ServerVersion string        `protobuf:"bytes,2,opt,name=serverVersion" json:"serverVersion,omitempty"`
+	ConfigStatus  *ConfigStatus `protobuf:"bytes,3,opt,name=configStatus" json:"configStatus,omitempty"`
 }
 
 func (m *ServerStatusReply) Reset()                    { *m = ServerStatusReply{} }
@@ -66,6 +67,13 @@ func (m *ServerStatusReply) GetServerVersion() string {
 	return ""
 }
 
+func (m *ServerStatusReply) GetConfigStatus() *ConfigStatus {
+	if m != nil {
+		return m.ConfigStatus
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Reply)(nil), "ricochet.Reply")
 	proto.RegisterType((*ServerStatusRequest)(nil), "ricochet.ServerStatusRequest")
@@ -99,7 +107,22 @@ type RicochetCoreClient interface {
 	// Stop all network connections and go offline. Blocks until the network
 	// has been taken offline, and returns the new network status.
 	StopNetwork(ctx context.Context, in *StopNetworkRequest, opts ...grpc.CallOption) (*NetworkStatus, error)
+	// Query the current settings, including defaults for any that are unset.
+	GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*Settings, error)
+	// Replace all settings, which take effect immediately. Invalid settings
+	// are rejected with an error. Returns the new settings.
+	UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*Settings, error)
+	// Open a stream to monitor the configuration. The current Config is sent
+	// immediately, and the stream will receive the new Config after any
+	// changes until the stream is closed. Secrets are never included.
+	MonitorConfig(ctx context.Context, in *MonitorConfigRequest, opts ...grpc.CallOption) (RicochetCore_MonitorConfigClient, error)
+	// Reload the configuration from storage to apply changes that were made
+	// while the backend is running. If any changes are invalid or can't be
+	// applied, an error is returned and the configuration is unchanged.
+	ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*Config, error)
 	GetIdentity(ctx context.Context, in *IdentityRequest, opts ...grpc.CallOption) (*Identity, error)
+	// Render our contact URI as a QR code image, for sharing in person
+	GetIdentityCard(ctx context.Context, in *IdentityCardRequest, opts ...grpc.CallOption) (*IdentityCard, error)
 	// Query contacts and monitor for contact changes. The full contact list
 	// is sent in POPULATE events, terminated by a POPULATE event with no
 	// subject. Any new, removed, or modified contacts, including changes in
@@ -107,10 +130,58 @@ type RicochetCoreClient interface {
 	// the stream is closed.
 	MonitorContacts(ctx context.Context, in *MonitorContactsRequest, opts ...grpc.CallOption) (RicochetCore_MonitorContactsClient, error)
 	AddContactRequest(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*Contact, error)
-	UpdateContact(ctx context.Context, in *Contact, opts ...grpc.CallOption) (*Contact, error)
+	// Change mutable fields of a contact, such as the nickname. Returns
+	// an error if a field can't be changed or the new value is invalid.
+	UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*Contact, error)
 	DeleteContact(ctx context.Context, in *DeleteContactRequest, opts ...grpc.CallOption) (*DeleteContactReply, error)
 	AcceptInboundRequest(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*Contact, error)
 	RejectInboundRequest(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*RejectInboundRequestReply, error)
+	// Manage outbound contact requests that haven't been accepted yet.
+	// Withdraw removes the contact; the peer keeps the request if it was
+	// already delivered. Edit changes fromNickname and text before the
+	// request is delivered, or before it's retried. Retry sends a rejected,
+	// failed, or expired request again, after a cooldown for rejections. For
+	// a contact who removed us, retry sends a new request with fromNickname
+	// and text.
+	WithdrawOutboundRequest(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*WithdrawOutboundRequestReply, error)
+	EditOutboundRequest(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*Contact, error)
+	RetryOutboundRequest(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*Contact, error)
+	// Query the code used to verify a contact out of band, and record the
+	// result of verification.
+	GetVerificationCode(ctx context.Context, in *VerificationCodeRequest, opts ...grpc.CallOption) (*VerificationCode, error)
+	MarkVerified(ctx context.Context, in *MarkVerifiedRequest, opts ...grpc.CallOption) (*Contact, error)
+	// Query when a contact was recently connected, and for how long
+	GetContactHistory(ctx context.Context, in *ContactHistoryRequest, opts ...grpc.CallOption) (*ContactHistory, error)
+	// Block an address, which removes any contact or inbound request for it.
+	// Blocked addresses are refused when they connect and are never contacted.
+	BlockContact(ctx context.Context, in *BlockContactRequest, opts ...grpc.CallOption) (*BlockedContact, error)
+	UnblockContact(ctx context.Context, in *UnblockContactRequest, opts ...grpc.CallOption) (*UnblockContactReply, error)
+	ListBlockedContacts(ctx context.Context, in *ListBlockedContactsRequest, opts ...grpc.CallOption) (*ListBlockedContactsReply, error)
+	// Query counters for inbound contact requests refused by rate limits
+	GetInboundRequestStats(ctx context.Context, in *InboundRequestStatsRequest, opts ...grpc.CallOption) (*InboundRequestStats, error)
+	// Create an invite with a link that can be shared, which allows contact
+	// requests that include its token to be accepted automatically.
+	CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*Invite, error)
+	ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesReply, error)
+	RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*RevokeInviteReply, error)
+	// Query or change the policy that answers new inbound contact requests
+	// automatically, and list the recent decisions it made.
+	GetRequestPolicy(ctx context.Context, in *GetRequestPolicyRequest, opts ...grpc.CallOption) (*RequestPolicy, error)
+	SetRequestPolicy(ctx context.Context, in *SetRequestPolicyRequest, opts ...grpc.CallOption) (*RequestPolicy, error)
+	ListRequestPolicyDecisions(ctx context.Context, in *ListRequestPolicyDecisionsRequest, opts ...grpc.CallOption) (*ListRequestPolicyDecisionsReply, error)
+	// Export contacts as a file. Import adds a contact request for each
+	// contact in a file, waiting between requests, and sends a result for
+	// each contact as it's handled. Existing contacts are skipped.
+	ExportContacts(ctx context.Context, in *ExportContactsRequest, opts ...grpc.CallOption) (*ExportContactsReply, error)
+	ImportContacts(ctx context.Context, in *ImportContactsRequest, opts ...grpc.CallOption) (RicochetCore_ImportContactsClient, error)
+	// Send a contact a message introducing another contact, which they can
+	// add with a contact request. A mutual introduction also sends the other
+	// contact an introduction back. Without an introduced contact, we
+	// introduce ourselves with an invite that the contact can pass on.
+	IntroduceContact(ctx context.Context, in *IntroduceContactRequest, opts ...grpc.CallOption) (*IntroduceContactReply, error)
+	// Parse and validate a ricochet: URI, returning the address and any
+	// suggested request parameters. Nothing is changed.
+	ParseContactURI(ctx context.Context, in *ParseContactURIRequest, opts ...grpc.CallOption) (*ContactURI, error)
 	// Open a stream to monitor messages in conversations with contacts.
 	MonitorConversations(ctx context.Context, in *MonitorConversationsRequest, opts ...grpc.CallOption) (RicochetCore_MonitorConversationsClient, error)
 	SendMessage(ctx context.Context, in *Message, opts ...grpc.CallOption) (*Message, error)
@@ -184,6 +255,65 @@ func (c *ricochetCoreClient) StopNetwork(ctx context.Context, in *StopNetworkReq
 	return out, nil
 }
 
+func (c *ricochetCoreClient) GetSettings(ctx context.Context, in *GetSettingsRequest, opts ...grpc.CallOption) (*Settings, error) {
+	out := new(Settings)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/GetSettings", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) UpdateSettings(ctx context.Context, in *UpdateSettingsRequest, opts ...grpc.CallOption) (*Settings, error) {
+	out := new(Settings)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/UpdateSettings", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) MonitorConfig(ctx context.Context, in *MonitorConfigRequest, opts ...grpc.CallOption) (RicochetCore_MonitorConfigClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_RicochetCore_serviceDesc.Streams[1], c.cc, "/ricochet.RicochetCore/MonitorConfig", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ricochetCoreMonitorConfigClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RicochetCore_MonitorConfigClient interface {
+	Recv() (*Config, error)
+	grpc.ClientStream
+}
+
+type ricochetCoreMonitorConfigClient struct {
+	grpc.ClientStream
+}
+
+func (x *ricochetCoreMonitorConfigClient) Recv() (*Config, error) {
+	m := new(Config)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ricochetCoreClient) ReloadConfig(ctx context.Context, in *ReloadConfigRequest, opts ...grpc.CallOption) (*Config, error) {
+	out := new(Config)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/ReloadConfig", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ricochetCoreClient) GetIdentity(ctx context.Context, in *IdentityRequest, opts ...grpc.CallOption) (*Identity, error) {
 	out := new(Identity)
 	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/GetIdentity", in, out, c.cc, opts...)
@@ -193,8 +323,17 @@ func (c *ricochetCoreClient) GetIdentity(ctx context.Context, in *IdentityReques
 	return out, nil
 }
 
+func (c *ricochetCoreClient) GetIdentityCard(ctx context.Context, in *IdentityCardRequest, opts ...grpc.CallOption) (*IdentityCard, error) {
+	out := new(IdentityCard)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/GetIdentityCard", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ricochetCoreClient) MonitorContacts(ctx context.Context, in *MonitorContactsRequest, opts ...grpc.CallOption) (RicochetCore_MonitorContactsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_RicochetCore_serviceDesc.Streams[1], c.cc, "/ricochet.RicochetCore/MonitorContacts", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_RicochetCore_serviceDesc.Streams[2], c.cc, "/ricochet.RicochetCore/MonitorContacts", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -234,7 +373,7 @@ func (c *ricochetCoreClient) AddContactRequest(ctx context.Context, in *ContactR
 	return out, nil
 }
 
-func (c *ricochetCoreClient) UpdateContact(ctx context.Context, in *Contact, opts ...grpc.CallOption) (*Contact, error) {
+func (c *ricochetCoreClient) UpdateContact(ctx context.Context, in *UpdateContactRequest, opts ...grpc.CallOption) (*Contact, error) {
 	out := new(Contact)
 	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/UpdateContact", in, out, c.cc, opts...)
 	if err != nil {
@@ -270,8 +409,211 @@ func (c *ricochetCoreClient) RejectInboundRequest(ctx context.Context, in *Conta
 	return out, nil
 }
 
+func (c *ricochetCoreClient) WithdrawOutboundRequest(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*WithdrawOutboundRequestReply, error) {
+	out := new(WithdrawOutboundRequestReply)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/WithdrawOutboundRequest", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) EditOutboundRequest(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*Contact, error) {
+	out := new(Contact)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/EditOutboundRequest", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) RetryOutboundRequest(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*Contact, error) {
+	out := new(Contact)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/RetryOutboundRequest", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) GetVerificationCode(ctx context.Context, in *VerificationCodeRequest, opts ...grpc.CallOption) (*VerificationCode, error) {
+	out := new(VerificationCode)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/GetVerificationCode", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) MarkVerified(ctx context.Context, in *MarkVerifiedRequest, opts ...grpc.CallOption) (*Contact, error) {
+	out := new(Contact)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/MarkVerified", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) GetContactHistory(ctx context.Context, in *ContactHistoryRequest, opts ...grpc.CallOption) (*ContactHistory, error) {
+	out := new(ContactHistory)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/GetContactHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) BlockContact(ctx context.Context, in *BlockContactRequest, opts ...grpc.CallOption) (*BlockedContact, error) {
+	out := new(BlockedContact)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/BlockContact", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) UnblockContact(ctx context.Context, in *UnblockContactRequest, opts ...grpc.CallOption) (*UnblockContactReply, error) {
+	out := new(UnblockContactReply)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/UnblockContact", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) ListBlockedContacts(ctx context.Context, in *ListBlockedContactsRequest, opts ...grpc.CallOption) (*ListBlockedContactsReply, error) {
+	out := new(ListBlockedContactsReply)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/ListBlockedContacts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) GetInboundRequestStats(ctx context.Context, in *InboundRequestStatsRequest, opts ...grpc.CallOption) (*InboundRequestStats, error) {
+	out := new(InboundRequestStats)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/GetInboundRequestStats", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*Invite, error) {
+	out := new(Invite)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/CreateInvite", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) ListInvites(ctx context.Context, in *ListInvitesRequest, opts ...grpc.CallOption) (*ListInvitesReply, error) {
+	out := new(ListInvitesReply)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/ListInvites", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) RevokeInvite(ctx context.Context, in *RevokeInviteRequest, opts ...grpc.CallOption) (*RevokeInviteReply, error) {
+	out := new(RevokeInviteReply)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/RevokeInvite", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) GetRequestPolicy(ctx context.Context, in *GetRequestPolicyRequest, opts ...grpc.CallOption) (*RequestPolicy, error) {
+	out := new(RequestPolicy)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/GetRequestPolicy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) SetRequestPolicy(ctx context.Context, in *SetRequestPolicyRequest, opts ...grpc.CallOption) (*RequestPolicy, error) {
+	out := new(RequestPolicy)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/SetRequestPolicy", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) ListRequestPolicyDecisions(ctx context.Context, in *ListRequestPolicyDecisionsRequest, opts ...grpc.CallOption) (*ListRequestPolicyDecisionsReply, error) {
+	out := new(ListRequestPolicyDecisionsReply)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/ListRequestPolicyDecisions", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) ExportContacts(ctx context.Context, in *ExportContactsRequest, opts ...grpc.CallOption) (*ExportContactsReply, error) {
+	out := new(ExportContactsReply)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/ExportContacts", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) ImportContacts(ctx context.Context, in *ImportContactsRequest, opts ...grpc.CallOption) (RicochetCore_ImportContactsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_RicochetCore_serviceDesc.Streams[3], c.cc, "/ricochet.RicochetCore/ImportContacts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ricochetCoreImportContactsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RicochetCore_ImportContactsClient interface {
+	Recv() (*ImportContactResult, error)
+	grpc.ClientStream
+}
+
+type ricochetCoreImportContactsClient struct {
+	grpc.ClientStream
+}
+
+func (x *ricochetCoreImportContactsClient) Recv() (*ImportContactResult, error) {
+	m := new(ImportContactResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ricochetCoreClient) IntroduceContact(ctx context.Context, in *IntroduceContactRequest, opts ...grpc.CallOption) (*IntroduceContactReply, error) {
+	out := new(IntroduceContactReply)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/IntroduceContact", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ricochetCoreClient) ParseContactURI(ctx context.Context, in *ParseContactURIRequest, opts ...grpc.CallOption) (*ContactURI, error) {
+	out := new(ContactURI)
+	err := grpc.Invoke(ctx, "/ricochet.RicochetCore/ParseContactURI", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ricochetCoreClient) MonitorConversations(ctx context.Context, in *MonitorConversationsRequest, opts ...grpc.CallOption) (RicochetCore_MonitorConversationsClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_RicochetCore_serviceDesc.Streams[2], c.cc, "/ricochet.RicochetCore/MonitorConversations", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_RicochetCore_serviceDesc.Streams[4], c.cc, "/ricochet.RicochetCore/MonitorConversations", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -339,7 +681,22 @@ type RicochetCoreServer interface {
 	// Stop all network connections and go offline. Blocks until the network
 	// has been taken offline, and returns the new network status.
 	StopNetwork(context.Context, *StopNetworkRequest) (*NetworkStatus, error)
+	// Query the current settings, including defaults for any that are unset.
+	GetSettings(context.Context, *GetSettingsRequest) (*Settings, error)
+	// Replace all settings, which take effect immediately. Invalid settings
+	// are rejected with an error. Returns the new settings.
+	UpdateSettings(context.Context, *UpdateSettingsRequest) (*Settings, error)
+	// Open a stream to monitor the configuration. The current Config is sent
+	// immediately, and the stream will receive the new Config after any
+	// changes until the stream is closed. Secrets are never included.
+	MonitorConfig(*MonitorConfigRequest, RicochetCore_MonitorConfigServer) error
+	// Reload the configuration from storage to apply changes that were made
+	// while the backend is running. If any changes are invalid or can't be
+	// applied, an error is returned and the configuration is unchanged.
+	ReloadConfig(context.Context, *ReloadConfigRequest) (*Config, error)
 	GetIdentity(context.Context, *IdentityRequest) (*Identity, error)
+	// Render our contact URI as a QR code image, for sharing in person
+	GetIdentityCard(context.Context, *IdentityCardRequest) (*IdentityCard, error)
 	// Query contacts and monitor for contact changes. The full contact list
 	// is sent in POPULATE events, terminated by a POPULATE event with no
 	// subject. Any new, removed, or modified contacts, including changes in
@@ -347,10 +704,58 @@ type RicochetCoreServer interface {
 	// the stream is closed.
 	MonitorContacts(*MonitorContactsRequest, RicochetCore_MonitorContactsServer) error
 	AddContactRequest(context.Context, *ContactRequest) (*Contact, error)
-	UpdateContact(context.Context, *Contact) (*Contact, error)
+	// Change mutable fields of a contact, such as the nickname. Returns
+	// an error if a field can't be changed or the new value is invalid.
+	UpdateContact(context.Context, *UpdateContactRequest) (*Contact, error)
 	DeleteContact(context.Context, *DeleteContactRequest) (*DeleteContactReply, error)
 	AcceptInboundRequest(context.Context, *ContactRequest) (*Contact, error)
 	RejectInboundRequest(context.Context, *ContactRequest) (*RejectInboundRequestReply, error)
+	// Manage outbound contact requests that haven't been accepted yet.
+	// Withdraw removes the contact; the peer keeps the request if it was
+	// already delivered. Edit changes fromNickname and text before the
+	// request is delivered, or before it's retried. Retry sends a rejected,
+	// failed, or expired request again, after a cooldown for rejections. For
+	// a contact who removed us, retry sends a new request with fromNickname
+	// and text.
+	WithdrawOutboundRequest(context.Context, *ContactRequest) (*WithdrawOutboundRequestReply, error)
+	EditOutboundRequest(context.Context, *ContactRequest) (*Contact, error)
+	RetryOutboundRequest(context.Context, *ContactRequest) (*Contact, error)
+	// Query the code used to verify a contact out of band, and record the
+	// result of verification.
+	GetVerificationCode(context.Context, *VerificationCodeRequest) (*VerificationCode, error)
+	MarkVerified(context.Context, *MarkVerifiedRequest) (*Contact, error)
+	// Query when a contact was recently connected, and for how long
+	GetContactHistory(context.Context, *ContactHistoryRequest) (*ContactHistory, error)
+	// Block an address, which removes any contact or inbound request for it.
+	// Blocked addresses are refused when they connect and are never contacted.
+	BlockContact(context.Context, *BlockContactRequest) (*BlockedContact, error)
+	UnblockContact(context.Context, *UnblockContactRequest) (*UnblockContactReply, error)
+	ListBlockedContacts(context.Context, *ListBlockedContactsRequest) (*ListBlockedContactsReply, error)
+	// Query counters for inbound contact requests refused by rate limits
+	GetInboundRequestStats(context.Context, *InboundRequestStatsRequest) (*InboundRequestStats, error)
+	// Create an invite with a link that can be shared, which allows contact
+	// requests that include its token to be accepted automatically.
+	CreateInvite(context.Context, *CreateInviteRequest) (*Invite, error)
+	ListInvites(context.Context, *ListInvitesRequest) (*ListInvitesReply, error)
+	RevokeInvite(context.Context, *RevokeInviteRequest) (*RevokeInviteReply, error)
+	// Query or change the policy that answers new inbound contact requests
+	// automatically, and list the recent decisions it made.
+	GetRequestPolicy(context.Context, *GetRequestPolicyRequest) (*RequestPolicy, error)
+	SetRequestPolicy(context.Context, *SetRequestPolicyRequest) (*RequestPolicy, error)
+	ListRequestPolicyDecisions(context.Context, *ListRequestPolicyDecisionsRequest) (*ListRequestPolicyDecisionsReply, error)
+	// Export contacts as a file. Import adds a contact request for each
+	// contact in a file, waiting between requests, and sends a result for
+	// each contact as it's handled. Existing contacts are skipped.
+	ExportContacts(context.Context, *ExportContactsRequest) (*ExportContactsReply, error)
+	ImportContacts(*ImportContactsRequest, RicochetCore_ImportContactsServer) error
+	// Send a contact a message introducing another contact, which they can
+	// add with a contact request. A mutual introduction also sends the other
+	// contact an introduction back. Without an introduced contact, we
+	// introduce ourselves with an invite that the contact can pass on.
+	IntroduceContact(context.Context, *IntroduceContactRequest) (*IntroduceContactReply, error)
+	// Parse and validate a ricochet: URI, returning the address and any
+	// suggested request parameters. Nothing is changed.
+	ParseContactURI(context.Context, *ParseContactURIRequest) (*ContactURI, error)
 	// Open a stream to monitor messages in conversations with contacts.
 	MonitorConversations(*MonitorConversationsRequest, RicochetCore_MonitorConversationsServer) error
 	SendMessage(context.Context, *Message) (*Message, error)
@@ -436,115 +841,208 @@ func _RicochetCore_StopNetwork_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RicochetCore_GetIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(IdentityRequest)
+func _RicochetCore_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RicochetCoreServer).GetIdentity(ctx, in)
+		return srv.(RicochetCoreServer).GetSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ricochet.RicochetCore/GetIdentity",
+		FullMethod: "/ricochet.RicochetCore/GetSettings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RicochetCoreServer).GetIdentity(ctx, req.(*IdentityRequest))
+		return srv.(RicochetCoreServer).GetSettings(ctx, req.(*GetSettingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RicochetCore_MonitorContacts_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(MonitorContactsRequest)
+func _RicochetCore_UpdateSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).UpdateSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/UpdateSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).UpdateSettings(ctx, req.(*UpdateSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_MonitorConfig_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MonitorConfigRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(RicochetCoreServer).MonitorContacts(m, &ricochetCoreMonitorContactsServer{stream})
+	return srv.(RicochetCoreServer).MonitorConfig(m, &ricochetCoreMonitorConfigServer{stream})
 }
 
-type RicochetCore_MonitorContactsServer interface {
-	Send(*ContactEvent) error
+type RicochetCore_MonitorConfigServer interface {
+	Send(*Config) error
 	grpc.ServerStream
 }
 
-type ricochetCoreMonitorContactsServer struct {
+type ricochetCoreMonitorConfigServer struct {
 	grpc.ServerStream
 }
 
-func (x *ricochetCoreMonitorContactsServer) Send(m *ContactEvent) error {
+func (x *ricochetCoreMonitorConfigServer) Send(m *Config) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _RicochetCore_AddContactRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContactRequest)
+func _RicochetCore_ReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RicochetCoreServer).AddContactRequest(ctx, in)
+		return srv.(RicochetCoreServer).ReloadConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ricochet.RicochetCore/AddContactRequest",
+		FullMethod: "/ricochet.RicochetCore/ReloadConfig",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RicochetCoreServer).AddContactRequest(ctx, req.(*ContactRequest))
+		return srv.(RicochetCoreServer).ReloadConfig(ctx, req.(*ReloadConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RicochetCore_UpdateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Contact)
+func _RicochetCore_GetIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RicochetCoreServer).UpdateContact(ctx, in)
+		return srv.(RicochetCoreServer).GetIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ricochet.RicochetCore/UpdateContact",
+		FullMethod: "/ricochet.RicochetCore/GetIdentity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RicochetCoreServer).UpdateContact(ctx, req.(*Contact))
+		return srv.(RicochetCoreServer).GetIdentity(ctx, req.(*IdentityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RicochetCore_DeleteContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteContactRequest)
+func _RicochetCore_GetIdentityCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IdentityCardRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RicochetCoreServer).DeleteContact(ctx, in)
+		return srv.(RicochetCoreServer).GetIdentityCard(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ricochet.RicochetCore/DeleteContact",
+		FullMethod: "/ricochet.RicochetCore/GetIdentityCard",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RicochetCoreServer).DeleteContact(ctx, req.(*DeleteContactRequest))
+		return srv.(RicochetCoreServer).GetIdentityCard(ctx, req.(*IdentityCardRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _RicochetCore_AcceptInboundRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContactRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(RicochetCoreServer).AcceptInboundRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ricochet.RicochetCore/AcceptInboundRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RicochetCoreServer).AcceptInboundRequest(ctx, req.(*ContactRequest))
+func _RicochetCore_MonitorContacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MonitorContactsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(RicochetCoreServer).MonitorContacts(m, &ricochetCoreMonitorContactsServer{stream})
+}
+
+type RicochetCore_MonitorContactsServer interface {
+	Send(*ContactEvent) error
+	grpc.ServerStream
+}
+
+type ricochetCoreMonitorContactsServer struct {
+	grpc.ServerStream
+}
+
+func (x *ricochetCoreMonitorContactsServer) Send(m *ContactEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RicochetCore_AddContactRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).AddContactRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/AddContactRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).AddContactRequest(ctx, req.(*ContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_UpdateContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).UpdateContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/UpdateContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).UpdateContact(ctx, req.(*UpdateContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_DeleteContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).DeleteContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/DeleteContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).DeleteContact(ctx, req.(*DeleteContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_AcceptInboundRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).AcceptInboundRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/AcceptInboundRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).AcceptInboundRequest(ctx, req.(*ContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
 func _RicochetCore_RejectInboundRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -565,6 +1063,369 @@ func _RicochetCore_RejectInboundRequest_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RicochetCore_WithdrawOutboundRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).WithdrawOutboundRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/WithdrawOutboundRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).WithdrawOutboundRequest(ctx, req.(*ContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_EditOutboundRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).EditOutboundRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/EditOutboundRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).EditOutboundRequest(ctx, req.(*ContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_RetryOutboundRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).RetryOutboundRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/RetryOutboundRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).RetryOutboundRequest(ctx, req.(*ContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_GetVerificationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerificationCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).GetVerificationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/GetVerificationCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).GetVerificationCode(ctx, req.(*VerificationCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_MarkVerified_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkVerifiedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).MarkVerified(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/MarkVerified",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).MarkVerified(ctx, req.(*MarkVerifiedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_GetContactHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).GetContactHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/GetContactHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).GetContactHistory(ctx, req.(*ContactHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_BlockContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).BlockContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/BlockContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).BlockContact(ctx, req.(*BlockContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_UnblockContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).UnblockContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/UnblockContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).UnblockContact(ctx, req.(*UnblockContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_ListBlockedContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).ListBlockedContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/ListBlockedContacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).ListBlockedContacts(ctx, req.(*ListBlockedContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_GetInboundRequestStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InboundRequestStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).GetInboundRequestStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/GetInboundRequestStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).GetInboundRequestStats(ctx, req.(*InboundRequestStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/CreateInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).CreateInvite(ctx, req.(*CreateInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).ListInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/ListInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).ListInvites(ctx, req.(*ListInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_RevokeInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).RevokeInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/RevokeInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).RevokeInvite(ctx, req.(*RevokeInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_GetRequestPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequestPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).GetRequestPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/GetRequestPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).GetRequestPolicy(ctx, req.(*GetRequestPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_SetRequestPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequestPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).SetRequestPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/SetRequestPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).SetRequestPolicy(ctx, req.(*SetRequestPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_ListRequestPolicyDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequestPolicyDecisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).ListRequestPolicyDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/ListRequestPolicyDecisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).ListRequestPolicyDecisions(ctx, req.(*ListRequestPolicyDecisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_ExportContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).ExportContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/ExportContacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).ExportContacts(ctx, req.(*ExportContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_ImportContacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImportContactsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RicochetCoreServer).ImportContacts(m, &ricochetCoreImportContactsServer{stream})
+}
+
+type RicochetCore_ImportContactsServer interface {
+	Send(*ImportContactResult) error
+	grpc.ServerStream
+}
+
+type ricochetCoreImportContactsServer struct {
+	grpc.ServerStream
+}
+
+func (x *ricochetCoreImportContactsServer) Send(m *ImportContactResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RicochetCore_IntroduceContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntroduceContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).IntroduceContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/IntroduceContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).IntroduceContact(ctx, req.(*IntroduceContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RicochetCore_ParseContactURI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParseContactURIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RicochetCoreServer).ParseContactURI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ricochet.RicochetCore/ParseContactURI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RicochetCoreServer).ParseContactURI(ctx, req.(*ParseContactURIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RicochetCore_MonitorConversations_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(MonitorConversationsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -638,10 +1499,26 @@ var _RicochetCore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StopNetwork",
 			Handler:    _RicochetCore_StopNetwork_Handler,
 		},
+		{
+			MethodName: "GetSettings",
+			Handler:    _RicochetCore_GetSettings_Handler,
+		},
+		{
+			MethodName: "UpdateSettings",
+			Handler:    _RicochetCore_UpdateSettings_Handler,
+		},
+		{
+			MethodName: "ReloadConfig",
+			Handler:    _RicochetCore_ReloadConfig_Handler,
+		},
 		{
 			MethodName: "GetIdentity",
 			Handler:    _RicochetCore_GetIdentity_Handler,
 		},
+		{
+			MethodName: "GetIdentityCard",
+			Handler:    _RicochetCore_GetIdentityCard_Handler,
+		},
 		{
 			MethodName: "AddContactRequest",
 			Handler:    _RicochetCore_AddContactRequest_Handler,
@@ -662,6 +1539,82 @@ var _RicochetCore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RejectInboundRequest",
 			Handler:    _RicochetCore_RejectInboundRequest_Handler,
 		},
+		{
+			MethodName: "WithdrawOutboundRequest",
+			Handler:    _RicochetCore_WithdrawOutboundRequest_Handler,
+		},
+		{
+			MethodName: "EditOutboundRequest",
+			Handler:    _RicochetCore_EditOutboundRequest_Handler,
+		},
+		{
+			MethodName: "RetryOutboundRequest",
+			Handler:    _RicochetCore_RetryOutboundRequest_Handler,
+		},
+		{
+			MethodName: "GetVerificationCode",
+			Handler:    _RicochetCore_GetVerificationCode_Handler,
+		},
+		{
+			MethodName: "MarkVerified",
+			Handler:    _RicochetCore_MarkVerified_Handler,
+		},
+		{
+			MethodName: "GetContactHistory",
+			Handler:    _RicochetCore_GetContactHistory_Handler,
+		},
+		{
+			MethodName: "BlockContact",
+			Handler:    _RicochetCore_BlockContact_Handler,
+		},
+		{
+			MethodName: "UnblockContact",
+			Handler:    _RicochetCore_UnblockContact_Handler,
+		},
+		{
+			MethodName: "ListBlockedContacts",
+			Handler:    _RicochetCore_ListBlockedContacts_Handler,
+		},
+		{
+			MethodName: "GetInboundRequestStats",
+			Handler:    _RicochetCore_GetInboundRequestStats_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _RicochetCore_CreateInvite_Handler,
+		},
+		{
+			MethodName: "ListInvites",
+			Handler:    _RicochetCore_ListInvites_Handler,
+		},
+		{
+			MethodName: "RevokeInvite",
+			Handler:    _RicochetCore_RevokeInvite_Handler,
+		},
+		{
+			MethodName: "GetRequestPolicy",
+			Handler:    _RicochetCore_GetRequestPolicy_Handler,
+		},
+		{
+			MethodName: "SetRequestPolicy",
+			Handler:    _RicochetCore_SetRequestPolicy_Handler,
+		},
+		{
+			MethodName: "ListRequestPolicyDecisions",
+			Handler:    _RicochetCore_ListRequestPolicyDecisions_Handler,
+		},
+		{
+			MethodName: "ExportContacts",
+			Handler:    _RicochetCore_ExportContacts_Handler,
+		},
+		{
+			MethodName: "IntroduceContact",
+			Handler:    _RicochetCore_IntroduceContact_Handler,
+		},
+		{
+			MethodName: "ParseContactURI",
+			Handler:    _RicochetCore_ParseContactURI_Handler,
+		},
 		{
 			MethodName: "SendMessage",
 			Handler:    _RicochetCore_SendMessage_Handler,
@@ -677,11 +1630,21 @@ var _RicochetCore_serviceDesc = grpc.ServiceDesc{
 			Handler:       _RicochetCore_MonitorNetwork_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "MonitorConfig",
+			Handler:       _RicochetCore_MonitorConfig_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "MonitorContacts",
 			Handler:       _RicochetCore_MonitorContacts_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ImportContacts",
+			Handler:       _RicochetCore_ImportContacts_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "MonitorConversations",
 			Handler:       _RicochetCore_MonitorConversations_Handler,
@@ -694,34 +1657,64 @@ var _RicochetCore_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("core.proto", fileDescriptor2) }
 
 var fileDescriptor2 = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0x9c, 0x94, 0xd1, 0x6b, 0xd4, 0x40,
-	0x10, 0xc6, 0x89, 0x50, 0xad, 0x73, 0x4d, 0xcb, 0x8d, 0x87, 0xd6, 0xb3, 0xd6, 0xe3, 0x54, 0xe8,
-	0xd3, 0x51, 0x2c, 0x7d, 0xf3, 0xc1, 0x72, 0xd5, 0x52, 0x30, 0x7d, 0x48, 0xa8, 0x20, 0xf8, 0x92,
-	0x6e, 0x06, 0x8d, 0x3d, 0x76, 0xe3, 0xee, 0xdc, 0xc9, 0xfd, 0x11, 0xfe, 0xcf, 0x72, 0xcd, 0x6e,
-	0x77, 0x43, 0x72, 0x9c, 0xf8, 0x98, 0xef, 0xf7, 0xcd, 0x97, 0xd9, 0xd9, 0x49, 0x00, 0x84, 0xd2,
-	0x34, 0xa9, 0xb4, 0x62, 0x85, 0xdb, 0xba, 0x14, 0x4a, 0xfc, 0x20, 0x1e, 0xc6, 0x92, 0xf8, 0xb7,
-	0xd2, 0xb7, 0x35, 0x18, 0xee, 0x96, 0x05, 0x49, 0x2e, 0x79, 0x69, 0x9f, 0x63, 0xa1, 0x24, 0xe7,
-	0x82, 0xed, 0x23, 0x0a, 0x25, 0x17, 0xa4, 0x4d, 0xce, 0xa5, 0x92, 0xb5, 0x36, 0x7e, 0x04, 0x5b,
-	0x29, 0x55, 0xb3, 0xe5, 0xf8, 0x14, 0x9e, 0x64, 0xa4, 0x17, 0xa4, 0x33, 0xce, 0x79, 0x6e, 0x52,
-	0xfa, 0x35, 0x27, 0xc3, 0x78, 0x08, 0xa0, 0x2b, 0xf1, 0x85, 0xb4, 0x29, 0x95, 0xdc, 0x8f, 0x46,
-	0xd1, 0xd1, 0x56, 0x1a, 0x28, 0xe3, 0xaf, 0xd0, 0x6f, 0x96, 0x55, 0xb3, 0xe5, 0xa6, 0x22, 0x7c,
-	0x03, 0xb1, 0xb9, 0x2b, 0x72, 0x96, 0x07, 0xa3, 0xe8, 0xe8, 0x71, 0xda, 0x14, 0xdf, 0xfd, 0xd9,
-	0x86, 0x9d, 0xd4, 0x9e, 0x74, 0xaa, 0x34, 0x61, 0x02, 0x7b, 0x17, 0xc4, 0xe1, 0xeb, 0xf0, 0xe5,
-	0xc4, 0xcd, 0x62, 0xd2, 0xd1, 0xfd, 0xf0, 0xc5, 0x3a, 0xbc, 0xea, 0xf2, 0x33, 0xec, 0x26, 0x4a,
-	0x96, 0xac, 0xf4, 0x55, 0x3d, 0x45, 0x7c, 0xe5, 0xed, 0x4d, 0xe2, 0xf2, 0x9e, 0x79, 0x83, 0x25,
-	0x75, 0xe0, 0x71, 0x84, 0x9f, 0x60, 0x27, 0xe3, 0x5c, 0xb3, 0xcb, 0x0a, 0x3b, 0x0b, 0xf4, 0x4d,
-	0x49, 0x78, 0x0e, 0xbd, 0x8c, 0x55, 0xe5, 0x62, 0x0e, 0xc2, 0x98, 0x7b, 0x79, 0x63, 0xca, 0x7b,
-	0xe8, 0x5d, 0x10, 0x5f, 0xda, 0x75, 0xc0, 0xe7, 0xde, 0xe7, 0x34, 0x17, 0x81, 0x6d, 0xb4, 0x1a,
-	0xb4, 0x3d, 0xff, 0xb4, 0x5e, 0x20, 0x83, 0xa3, 0xd6, 0x68, 0x1c, 0x72, 0x41, 0x4f, 0xbd, 0xc3,
-	0xa2, 0x8f, 0x0b, 0x92, 0x7c, 0x1c, 0xe1, 0x07, 0xe8, 0x9f, 0x15, 0x85, 0x15, 0xdd, 0x62, 0xed,
-	0xb7, 0xec, 0x2e, 0xa8, 0xdf, 0x22, 0x78, 0x0a, 0xf1, 0x75, 0x55, 0xe4, 0x4c, 0x4e, 0x68, 0x7b,
-	0xba, 0xca, 0x12, 0x88, 0xcf, 0x69, 0x46, 0xbe, 0xec, 0xd0, 0x7b, 0x1a, 0xc0, 0xbd, 0xfa, 0x60,
-	0x2d, 0x5f, 0x2d, 0xcc, 0x14, 0x06, 0x67, 0x42, 0x50, 0xc5, 0x97, 0xf2, 0x46, 0xcd, 0x65, 0xf1,
-	0x5f, 0x47, 0xb9, 0x86, 0x41, 0x4a, 0x3f, 0x49, 0xfc, 0x7b, 0xc8, 0x6b, 0x4f, 0xba, 0x2a, 0xeb,
-	0xde, 0xbe, 0xc1, 0xc0, 0xdf, 0xcb, 0xfd, 0x47, 0x6e, 0xf0, 0x6d, 0xd7, 0xbd, 0x79, 0xde, 0xf1,
-	0xa1, 0x84, 0xdc, 0xdd, 0xe0, 0x09, 0xf4, 0x32, 0x92, 0x45, 0x42, 0xc6, 0xe4, 0xdf, 0x29, 0x9c,
-	0xbe, 0x95, 0x86, 0x6d, 0x09, 0xaf, 0x60, 0x90, 0xe4, 0xfa, 0x36, 0xcc, 0x4b, 0x29, 0x2f, 0x1a,
-	0x2d, 0x75, 0x70, 0xd7, 0xd2, 0x5e, 0x78, 0xec, 0x6a, 0xb6, 0xbc, 0x79, 0x78, 0xf7, 0xc7, 0x3a,
-	0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xe8, 0x3e, 0x1e, 0x0b, 0x05, 0x00, 0x00,
+	// 937 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x97, 0x6d, 0x6f, 0x1b, 0x45,
+	0x10, 0xc7, 0x65, 0x50, 0x79, 0x18, 0x3f, 0x34, 0xd9, 0x58, 0x49, 0x30, 0x6d, 0x9a, 0x86, 0x82,
+	0x82, 0x90, 0xa2, 0xa8, 0x15, 0x6f, 0x10, 0x52, 0xda, 0x38, 0xc1, 0x35, 0xaa, 0x4d, 0x74, 0x26,
+	0xe1, 0x05, 0x20, 0x74, 0xd9, 0x9b, 0xa6, 0x4b, 0xdc, 0xdd, 0x63, 0x6f, 0xec, 0xe2, 0x0f, 0xc2,
+	0xf7, 0xe2, 0x23, 0xa1, 0xf5, 0xdd, 0xfa, 0x76, 0xed, 0x75, 0x6c, 0xe0, 0xe5, 0xfe, 0xff, 0xb3,
+	0x3f, 0xcf, 0xce, 0xcd, 0x3e, 0x18, 0x80, 0x2b, 0x8d, 0x47, 0xa9, 0x56, 0xa4, 0xd8, 0x47, 0x5a,
+	0x70, 0xc5, 0xdf, 0x20, 0xb5, 0xea, 0x12, 0xe9, 0x9d, 0xd2, 0xb7, 0xb9, 0xd1, 0x6a, 0x88, 0x04,
+	0x25, 0x09, 0x9a, 0x14, 0xe3, 0x3a, 0x57, 0x92, 0x62, 0x4e, 0xc5, 0x90, 0x71, 0x25, 0xc7, 0xa8,
+	0xb3, 0x98, 0x84, 0x92, 0x85, 0x56, 0xe3, 0x4a, 0xbe, 0x16, 0x37, 0xf9, 0xe8, 0xe0, 0x43, 0xb8,
+	0x17, 0x61, 0x3a, 0x9c, 0x1c, 0x7c, 0x0d, 0x5b, 0x03, 0xd4, 0x63, 0xd4, 0x03, 0x8a, 0x69, 0x94,
+	0x45, 0xf8, 0xc7, 0x08, 0x33, 0x62, 0x7b, 0x00, 0x3a, 0xe5, 0x57, 0xa8, 0x33, 0xa1, 0xe4, 0x6e,
+	0x65, 0xbf, 0x72, 0x78, 0x2f, 0x72, 0x94, 0x83, 0xbf, 0x2a, 0xb0, 0xe9, 0xcf, 0x4b, 0x87, 0x93,
+	0x55, 0xb3, 0xd8, 0x13, 0xa8, 0x67, 0xd3, 0x49, 0x36, 0xe4, 0xbd, 0xfd, 0xca, 0xe1, 0xc7, 0x91,
+	0x2f, 0xb2, 0x6f, 0xa0, 0xc8, 0x35, 0x47, 0xef, 0xbe, 0xbf, 0x5f, 0x39, 0xac, 0x3e, 0xdd, 0x3e,
+	0xb2, 0xc5, 0x38, 0x6a, 0x3b, 0x6e, 0xe4, 0xc5, 0x3e, 0xfd, 0x7b, 0x07, 0x6a, 0x51, 0x11, 0xd7,
+	0x56, 0x1a, 0x59, 0x0f, 0xee, 0x77, 0x90, 0xdc, 0x54, 0xd9, 0xc3, 0x92, 0x14, 0x58, 0x7a, 0xeb,
+	0xd3, 0x65, 0xb6, 0x59, 0xe1, 0x2b, 0x68, 0xf4, 0x94, 0x14, 0xa4, 0x74, 0x3f, 0xff, 0x20, 0xec,
+	0x51, 0x19, 0xee, 0x3b, 0x96, 0xb7, 0x53, 0x06, 0x14, 0x4e, 0x0e, 0x3c, 0xae, 0xb0, 0xef, 0xa0,
+	0x36, 0xa0, 0x58, 0x93, 0x65, 0xb9, 0x99, 0x39, 0xfa, 0x2a, 0x12, 0x3b, 0x83, 0xea, 0x80, 0x54,
+	0x6a, 0x31, 0x0f, 0x5c, 0x8c, 0x4a, 0xd7, 0xa5, 0x9c, 0x40, 0x75, 0x5a, 0x2a, 0x22, 0x21, 0x6f,
+	0x32, 0x97, 0xe2, 0xc8, 0x96, 0xc2, 0xdc, 0x2a, 0x15, 0x33, 0xce, 0xa1, 0x71, 0x99, 0x26, 0x31,
+	0xe1, 0x4c, 0x71, 0x8a, 0xe3, 0x3b, 0x77, 0x61, 0xda, 0x50, 0x2f, 0x2a, 0x99, 0x7f, 0x68, 0xb6,
+	0xb7, 0x50, 0xe2, 0xdc, 0xb0, 0x90, 0x8d, 0xf9, 0xd6, 0x38, 0xae, 0xb0, 0x13, 0xa8, 0x45, 0x38,
+	0x54, 0x71, 0x52, 0x30, 0x9c, 0xd2, 0xba, 0xfa, 0x52, 0x04, 0xfb, 0x76, 0x5a, 0x8d, 0x6e, 0xb1,
+	0xcf, 0xd8, 0x27, 0x65, 0x80, 0xd5, 0x02, 0x6b, 0x98, 0x85, 0xbf, 0x9c, 0xb6, 0x9d, 0x1d, 0xb6,
+	0x63, 0x9d, 0xb8, 0x19, 0xb8, 0xba, 0xa5, 0x6c, 0x87, 0x6d, 0xd3, 0xc0, 0xe5, 0xa2, 0x29, 0xe6,
+	0x94, 0xb1, 0xfd, 0x50, 0x3d, 0xa6, 0x56, 0x00, 0x56, 0x58, 0xe7, 0x63, 0x94, 0x74, 0x5c, 0x61,
+	0xcf, 0x61, 0xf3, 0x45, 0x92, 0x14, 0xa2, 0xdd, 0xed, 0xbb, 0x0b, 0xe1, 0x16, 0xb4, 0xb9, 0xe0,
+	0xb0, 0x53, 0xa8, 0xe7, 0xdf, 0xd2, 0x0a, 0x7b, 0xf3, 0x1f, 0x79, 0x35, 0xa3, 0x07, 0xf5, 0x33,
+	0x1c, 0x62, 0x90, 0xe1, 0x19, 0x96, 0xf1, 0x60, 0xa9, 0x6f, 0x76, 0x65, 0x1b, 0x9a, 0x2f, 0x38,
+	0xc7, 0x94, 0xba, 0xf2, 0x5a, 0x8d, 0x64, 0xf2, 0x9f, 0xd6, 0x75, 0x09, 0xcd, 0x08, 0x7f, 0x47,
+	0xbe, 0x3e, 0xe4, 0x33, 0xb7, 0xa7, 0x16, 0x67, 0xe6, 0xb9, 0xfd, 0x0c, 0x3b, 0x3f, 0x09, 0x7a,
+	0x93, 0xe8, 0xf8, 0xdd, 0x0f, 0x23, 0x5a, 0x93, 0xfc, 0x45, 0xe9, 0x2c, 0x99, 0x9c, 0xc3, 0x4f,
+	0x61, 0xeb, 0x3c, 0x11, 0xb4, 0x3e, 0x38, 0xb0, 0xee, 0xb6, 0x59, 0x37, 0xe9, 0xc9, 0xff, 0x82,
+	0xfc, 0x08, 0x5b, 0x1d, 0xa4, 0x2b, 0xd4, 0xe2, 0xb5, 0xe0, 0xd3, 0x6b, 0xa7, 0xad, 0x12, 0x64,
+	0x8f, 0xcb, 0xc8, 0x79, 0xcf, 0xc2, 0x5a, 0xcb, 0x43, 0xd8, 0x73, 0xa8, 0xf5, 0x62, 0x7d, 0x9b,
+	0xeb, 0xe8, 0x6d, 0x21, 0x57, 0xbf, 0x23, 0xaf, 0x3e, 0x6c, 0x76, 0x90, 0x8a, 0xd1, 0x4b, 0x91,
+	0x91, 0xd2, 0x13, 0xf7, 0x54, 0xf2, 0x1d, 0x0b, 0xda, 0x5d, 0x16, 0xc0, 0x3a, 0x50, 0x3b, 0x1d,
+	0x2a, 0x7e, 0x6b, 0xf9, 0x4e, 0x46, 0xae, 0x1e, 0x00, 0x4d, 0x6d, 0xb4, 0x3b, 0x8f, 0x5d, 0x40,
+	0xe3, 0x52, 0x5e, 0xbb, 0x28, 0xf7, 0xac, 0x94, 0xd7, 0x01, 0xd8, 0xc3, 0xe5, 0x01, 0xa6, 0x17,
+	0x7e, 0x83, 0xad, 0x57, 0x22, 0x23, 0xff, 0x77, 0x32, 0xf6, 0xa4, 0x9c, 0x15, 0xb0, 0x2d, 0xfb,
+	0x60, 0x45, 0x94, 0xf9, 0x81, 0x5f, 0x61, 0xbb, 0x83, 0x73, 0x3d, 0x6e, 0xae, 0x0e, 0xef, 0x37,
+	0x02, 0x76, 0x20, 0xff, 0x10, 0xe4, 0x04, 0x6a, 0x6d, 0x8d, 0x31, 0x61, 0x57, 0x8e, 0x05, 0xa1,
+	0x5b, 0x5a, 0x57, 0x0f, 0x9c, 0xd8, 0xc5, 0x84, 0x0e, 0x54, 0x4d, 0xee, 0xf9, 0xc8, 0xbb, 0xbf,
+	0x1c, 0x39, 0xd0, 0x76, 0x9e, 0x6b, 0x16, 0xfa, 0xbd, 0xb9, 0x3b, 0xc6, 0xea, 0x36, 0x90, 0x89,
+	0xab, 0x07, 0x1e, 0x0c, 0xbe, 0x6d, 0x58, 0x7d, 0xd8, 0xe8, 0xa0, 0xfd, 0x84, 0x17, 0x6a, 0x28,
+	0xf8, 0xc4, 0xdd, 0x15, 0xf3, 0x5e, 0xe0, 0x92, 0xf6, 0xe7, 0xf6, 0x61, 0x63, 0x70, 0x07, 0x6f,
+	0xf0, 0x6f, 0x79, 0x04, 0x2d, 0xb3, 0x7e, 0x4f, 0x3c, 0x43, 0x2e, 0xcc, 0x4b, 0x2c, 0x63, 0x5f,
+	0xf9, 0x55, 0x0a, 0x47, 0xd9, 0xdf, 0xf8, 0x72, 0xbd, 0x60, 0x53, 0x95, 0x0b, 0x68, 0x9c, 0xff,
+	0x99, 0x2a, 0x4d, 0xb3, 0x36, 0x75, 0xba, 0xdf, 0x77, 0x02, 0xdd, 0x33, 0x1f, 0x60, 0x88, 0x11,
+	0x34, 0xba, 0x6f, 0x97, 0x11, 0xbb, 0x6f, 0x57, 0x10, 0xbd, 0x80, 0x08, 0xb3, 0xd1, 0xd0, 0xdc,
+	0x95, 0x57, 0xb0, 0xd1, 0x95, 0xa4, 0x55, 0x32, 0xe2, 0xb3, 0x8b, 0xea, 0xb1, 0xdb, 0x76, 0xbe,
+	0x67, 0xb9, 0x8f, 0xee, 0x0a, 0x31, 0xb9, 0x76, 0xe1, 0xfe, 0x45, 0xac, 0x33, 0x2b, 0x5e, 0x46,
+	0x5d, 0xf7, 0x4a, 0x9f, 0xb3, 0x2c, 0xb5, 0xb9, 0x70, 0x26, 0x99, 0x79, 0xbf, 0x40, 0xb3, 0x7c,
+	0x02, 0xcc, 0x9e, 0xfc, 0x19, 0xfb, 0x3c, 0xf4, 0x44, 0x28, 0xfd, 0x40, 0xeb, 0xba, 0xbe, 0x7d,
+	0x2c, 0x3c, 0x83, 0xea, 0x00, 0x65, 0xd2, 0xc3, 0x2c, 0x8b, 0x6f, 0x90, 0x39, 0xe7, 0x6b, 0x21,
+	0xb5, 0x16, 0x25, 0xd6, 0x87, 0xa6, 0x39, 0x9c, 0x5d, 0x5e, 0x84, 0x71, 0xe2, 0xa5, 0x14, 0xf0,
+	0x6d, 0x4a, 0xf7, 0xdd, 0x4e, 0x4d, 0x87, 0x93, 0xeb, 0x0f, 0xa6, 0xff, 0x58, 0x9e, 0xfd, 0x33,
+	0x00, 0x6c, 0x34, 0xa2, 0x78, 0x19, 0x0d, 0x00, 0x00,
 }
@@ -13,8 +13,34 @@ var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
 
+type IdentityCardRequest_Format int32
+
+const (
+	IdentityCardRequest_PNG IdentityCardRequest_Format = 0
+	IdentityCardRequest_SVG IdentityCardRequest_Format = 1
+)
+
+var IdentityCardRequest_Format_name = map[int32]string{
+	0: "PNG",
+	1: "SVG",
+}
+var IdentityCardRequest_Format_value = map[string]int32{
+	"PNG": 0,
+	"SVG": 1,
+}
+
+func (x IdentityCardRequest_Format) String() string {
+	return proto.EnumName(IdentityCardRequest_Format_name, int32(x))
+}
+func (IdentityCardRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor3, []int{2, 0}
+}
+
 type Identity struct {
 	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// The identity, contacts, and conversations are only kept in memory and
+	// are discarded when the backend exits.
+	Ephemeral bool `protobuf:"varint,2,opt,name=ephemeral" json:"ephemeral,omitempty"`
 }
 
 func (m *Identity) Reset()                    { *m = Identity{} }
@@ -29,6 +55,13 @@ func (m *Identity) GetAddress() string {
 	return ""
 }
 
+func (m *Identity) GetEphemeral() bool {
+	if m != nil {
+		return m.Ephemeral
+	}
+	return false
+}
+
 type IdentityRequest struct {
 }
 
@@ -37,19 +70,120 @@ func (m *IdentityRequest) String() string            { return proto.CompactTextS
 func (*IdentityRequest) ProtoMessage()               {}
 func (*IdentityRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{1} }
 
+// IdentityCardRequest asks for a QR code of our address, as a contact URI
+// with optional parameters.
+type IdentityCardRequest struct {
+	Format IdentityCardRequest_Format `protobuf:"varint,1,opt,name=format,enum=ricochet.IdentityCardRequest_Format" json:"format,omitempty"`
+	// Pixels per QR module for PNG images; the default is 8
+	Scale uint32 `protobuf:"varint,2,opt,name=scale" json:"scale,omitempty"`
+	// Optional URI parameters, as in ContactURI
+	Nickname string `protobuf:"bytes,3,opt,name=nickname" json:"nickname,omitempty"`
+	Message  string `protobuf:"bytes,4,opt,name=message" json:"message,omitempty"`
+	Invite   string `protobuf:"bytes,5,opt,name=invite" json:"invite,omitempty"`
+}
+
+func (m *IdentityCardRequest) Reset()                    { *m = IdentityCardRequest{} }
+func (m *IdentityCardRequest) String() string            { return proto.CompactTextString(m) }
+func (*IdentityCardRequest) ProtoMessage()               {}
+func (*IdentityCardRequest) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{2} }
+
+func (m *IdentityCardRequest) GetFormat() IdentityCardRequest_Format {
+	if m != nil {
+		return m.Format
+	}
+	return IdentityCardRequest_PNG
+}
+
+func (m *IdentityCardRequest) GetScale() uint32 {
+	if m != nil {
+		return m.Scale
+	}
+	return 0
+}
+
+func (m *IdentityCardRequest) GetNickname() string {
+	if m != nil {
+		return m.Nickname
+	}
+	return ""
+}
+
+func (m *IdentityCardRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *IdentityCardRequest) GetInvite() string {
+	if m != nil {
+		return m.Invite
+	}
+	return ""
+}
+
+type IdentityCard struct {
+	// The encoded contact URI
+	Uri string `protobuf:"bytes,1,opt,name=uri" json:"uri,omitempty"`
+	// MIME type of the image, e.g. "image/png"
+	ContentType string `protobuf:"bytes,2,opt,name=contentType" json:"contentType,omitempty"`
+	Image       []byte `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (m *IdentityCard) Reset()                    { *m = IdentityCard{} }
+func (m *IdentityCard) String() string            { return proto.CompactTextString(m) }
+func (*IdentityCard) ProtoMessage()               {}
+func (*IdentityCard) Descriptor() ([]byte, []int) { return fileDescriptor3, []int{3} }
+
+func (m *IdentityCard) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *IdentityCard) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *IdentityCard) GetImage() []byte {
+	if m != nil {
+		return m.Image
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Identity)(nil), "ricochet.Identity")
 	proto.RegisterType((*IdentityRequest)(nil), "ricochet.IdentityRequest")
+	proto.RegisterType((*IdentityCardRequest)(nil), "ricochet.IdentityCardRequest")
+	proto.RegisterType((*IdentityCard)(nil), "ricochet.IdentityCard")
+	proto.RegisterEnum("ricochet.IdentityCardRequest_Format", IdentityCardRequest_Format_name, IdentityCardRequest_Format_value)
 }
 
 func init() { proto.RegisterFile("identity.proto", fileDescriptor3) }
 
 var fileDescriptor3 = []byte{
-	// 95 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x02, 0xff, 0xe2, 0xe2, 0xcb, 0x4c, 0x49, 0xcd,
-	0x2b, 0xc9, 0x2c, 0xa9, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x28, 0xca, 0x4c, 0xce,
-	0x4f, 0xce, 0x48, 0x2d, 0x51, 0x52, 0xe1, 0xe2, 0xf0, 0x84, 0xca, 0x09, 0x49, 0x70, 0xb1, 0x27,
-	0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xb8, 0x4a,
-	0x82, 0x5c, 0xfc, 0x30, 0x55, 0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x49, 0x6c, 0x60, 0x93,
-	0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcc, 0xc7, 0x44, 0x6d, 0x5b, 0x00, 0x00, 0x00,
+	// 280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4f, 0xfb, 0x30,
+	0x10, 0xc5, 0xff, 0xf9, 0x87, 0xa6, 0xc9, 0x51, 0x4a, 0x30, 0x08, 0x59, 0x15, 0x43, 0x14, 0x31,
+	0x74, 0xca, 0x00, 0x2b, 0x13, 0x48, 0x54, 0x2c, 0x08, 0x19, 0x84, 0x58, 0x4d, 0x72, 0xb4, 0x16,
+	0x8d, 0x1d, 0x6c, 0x17, 0xa9, 0x9f, 0x93, 0x2f, 0x84, 0x62, 0x3b, 0xd0, 0x81, 0xed, 0xde, 0xbb,
+	0xb3, 0xef, 0xf7, 0x6c, 0x98, 0x8a, 0x06, 0xa5, 0x15, 0x76, 0x5b, 0x75, 0x5a, 0x59, 0x45, 0x52,
+	0x2d, 0x6a, 0x55, 0xaf, 0xd0, 0x96, 0xd7, 0x90, 0xde, 0x85, 0x1e, 0xa1, 0x30, 0xe6, 0x4d, 0xa3,
+	0xd1, 0x18, 0x1a, 0x15, 0xd1, 0x3c, 0x63, 0x83, 0x24, 0x67, 0x90, 0x61, 0xb7, 0xc2, 0x16, 0x35,
+	0x5f, 0xd3, 0xff, 0x45, 0x34, 0x4f, 0xd9, 0xaf, 0x51, 0x1e, 0xc1, 0xe1, 0x70, 0x07, 0xc3, 0x8f,
+	0x0d, 0x1a, 0x5b, 0x7e, 0x45, 0x70, 0x3c, 0x78, 0x37, 0x5c, 0x37, 0xc1, 0x27, 0x57, 0x90, 0xbc,
+	0x29, 0xdd, 0x72, 0xeb, 0x36, 0x4c, 0x2f, 0xce, 0xab, 0x81, 0xa4, 0xfa, 0x63, 0xbc, 0xba, 0x75,
+	0xb3, 0x2c, 0x9c, 0x21, 0x27, 0x30, 0x32, 0x35, 0x5f, 0xa3, 0x43, 0x38, 0x60, 0x5e, 0x90, 0x19,
+	0xa4, 0x52, 0xd4, 0xef, 0x92, 0xb7, 0x48, 0x63, 0xc7, 0xfd, 0xa3, 0xfb, 0x48, 0x2d, 0x1a, 0xc3,
+	0x97, 0x48, 0xf7, 0x7c, 0xa4, 0x20, 0xc9, 0x29, 0x24, 0x42, 0x7e, 0x0a, 0x8b, 0x74, 0xe4, 0x1a,
+	0x41, 0x95, 0x33, 0x48, 0xfc, 0x56, 0x32, 0x86, 0xf8, 0xe1, 0x7e, 0x91, 0xff, 0xeb, 0x8b, 0xc7,
+	0xe7, 0x45, 0x1e, 0x95, 0x2f, 0x30, 0xd9, 0xa5, 0x24, 0x39, 0xc4, 0x1b, 0x2d, 0xc2, 0x63, 0xf5,
+	0x25, 0x29, 0x60, 0xbf, 0x56, 0xd2, 0xa2, 0xb4, 0x4f, 0xdb, 0xce, 0x73, 0x66, 0x6c, 0xd7, 0xea,
+	0x33, 0x88, 0x96, 0x2f, 0x3d, 0xea, 0x84, 0x79, 0xf1, 0x9a, 0xb8, 0x7f, 0xb9, 0xfc, 0x1e, 0x00,
+	0xde, 0x27, 0x9f, 0xfa, 0xa9, 0x01, 0x00, 0x00,
 }